@@ -0,0 +1,107 @@
+// Command modelbox-agent registers the node it runs on with ModelBox and runs
+// the instances of the given actions until it receives SIGTERM or SIGINT, at
+// which point it drains the running actions before exiting.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	client "github.com/tensorland/modelbox/sdk-go"
+	"github.com/tensorland/modelbox/sdk-go/agent"
+	"go.uber.org/zap"
+)
+
+func main() {
+	configPath := flag.String("config", "", "path of the client config")
+	profile := flag.String("profile", "", "client config profile")
+	serverAddr := flag.String("server-addr", "", "address of the admin api, overrides the client config")
+	name := flag.String("name", "default-agent", "agent name")
+	actions := flag.String("actions", "", "comma separated names of the actions to run")
+	arch := flag.String("arch", "", "architecture to run actions for, defaults to the node's")
+	ipAddr := flag.String("ip-addr", "", "advertised ip address of the node")
+	heartbeat := flag.Duration("heartbeat", agent.DEFAULT_HEARTBEAT_INTERVAL, "heartbeat interval")
+	poll := flag.Duration("poll", agent.DEFAULT_POLL_INTERVAL, "interval at which runnable actions are polled")
+	concurrency := flag.Int("concurrency", agent.DEFAULT_CONCURRENCY, "number of actions run at the same time")
+	drainTimeout := flag.Duration("drain-timeout", agent.DEFAULT_DRAIN_TIMEOUT, "time given to running actions to finish on shutdown")
+	timeout := flag.Duration("timeout", 0, "time after which actions are killed, 0 for no timeout")
+	memoryLimit := flag.Uint64("memory-limit", 0, "memory limit of actions in bytes, 0 for no limit")
+	cpuLimit := flag.Duration("cpu-limit", 0, "CPU time limit of actions, 0 for no limit")
+	workDir := flag.String("work-dir", "", "directory actions run in, defaults to the temporary directory")
+	flag.Parse()
+
+	logger, err := zap.NewProduction()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "unable to create logger: %v\n", err)
+		os.Exit(1)
+	}
+	defer logger.Sync()
+	opts := []agent.Option{
+		agent.WithHeartbeatInterval(*heartbeat),
+		agent.WithPollInterval(*poll),
+		agent.WithConcurrency(*concurrency),
+		agent.WithDrainTimeout(*drainTimeout),
+		agent.WithNodeAddr("", *ipAddr),
+		agent.WithLogger(logger),
+	}
+	if *arch != "" {
+		opts = append(opts, agent.WithArch(*arch))
+	}
+	executorOpts := []agent.ExecutorOption{
+		agent.WithTimeout(*timeout),
+		agent.WithMemoryLimit(*memoryLimit),
+		agent.WithCPULimit(*cpuLimit),
+		agent.WithWorkDir(*workDir),
+	}
+	if err := run(logger, *configPath, *profile, *serverAddr, *name, *actions, opts, executorOpts); err != nil {
+		logger.Fatal("agent failed", zap.Error(err))
+	}
+}
+
+func run(logger *zap.Logger, configPath, profile, serverAddr, name, actions string, opts []agent.Option, executorOpts []agent.ExecutorOption) error {
+	config, err := client.LoadClientConfig(configPath, profile)
+	if err != nil {
+		return err
+	}
+	if serverAddr != "" {
+		config.ServerAddr = serverAddr
+	}
+	admin, err := client.NewAdminClient(config.ServerAddr, config.Options()...)
+	if err != nil {
+		return err
+	}
+	defer admin.Close()
+	// logs of actions are uploaded through the model store
+	mb, err := client.NewModelBoxClient(config.ServerAddr, config.Options()...)
+	if err != nil {
+		return err
+	}
+	defer mb.Close()
+	executor := agent.NewExecutor(mb, executorOpts...)
+	a, err := agent.New(admin, name, splitActions(actions), executor, opts...)
+	if err != nil {
+		return err
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer stop()
+	logger.Info("starting agent", zap.String("server_addr", config.ServerAddr))
+	if err := a.Run(ctx); err != nil && ctx.Err() == nil {
+		return err
+	}
+	return nil
+}
+
+func splitActions(actions string) []string {
+	var names []string
+	for _, name := range strings.Split(actions, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
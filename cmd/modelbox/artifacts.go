@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	client "github.com/tensorland/modelbox/sdk-go"
+)
+
+func (c *cli) artifactsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "artifacts",
+		Aliases: []string{"artifact"},
+		Short:   "List, upload and download the artifacts of an object",
+	}
+	cmd.AddCommand(&cobra.Command{
+		Use:   "list <object-id>",
+		Short: "List the artifacts of an experiment, model or model version",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.run(cmd, func(ctx context.Context, mb *client.ModelBoxClient) error {
+				artifacts, err := mb.ListArtifacts(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				t := &table{header: []string{"artifact", "name", "file", "type", "path", "size", "checksum"}}
+				for _, a := range artifacts {
+					for _, f := range a.Files {
+						t.append(a.Id, a.Name, f.Id, f.FileType.String(), f.SrcPath,
+							strconv.FormatUint(f.Size, 10), f.ChecksumAlgorithm.String()+":"+f.Checksum)
+					}
+				}
+				return c.print(cmd.OutOrStdout(), artifacts, t)
+			})
+		},
+	})
+
+	var name, fileType string
+	upload := &cobra.Command{
+		Use:   "upload <object-id> <path>",
+		Short: "Upload a file as an artifact of an object",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.run(cmd, func(ctx context.Context, mb *client.ModelBoxClient) error {
+				t := client.FileTypeFromPath(args[1])
+				if fileType != "" {
+					t = client.FileTypeFromStr(fileType)
+				}
+				artifactName := name
+				if artifactName == "" {
+					artifactName = filepath.Base(args[1])
+				}
+				resp, err := mb.UploadFile(ctx, artifactName, args[0], args[1], t)
+				if err != nil {
+					return err
+				}
+				out := &table{header: []string{"file", "artifact", "checksum"}}
+				out.append(resp.Id, resp.ArtifactId, resp.Checksum)
+				return c.print(cmd.OutOrStdout(), resp, out)
+			})
+		},
+	}
+	upload.Flags().StringVar(&name, "name", "", "name of the artifact, defaults to the name of the file")
+	upload.Flags().StringVar(&fileType, "type", "", "type of the file, detected from its extension by default")
+	cmd.AddCommand(upload)
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "download <file-id> <path>",
+		Short: "Download a file and verify its checksum",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.run(cmd, func(ctx context.Context, mb *client.ModelBoxClient) error {
+				resp, err := mb.DownloadBlob(ctx, args[0], args[1])
+				if err != nil {
+					return err
+				}
+				t := &table{header: []string{"path", "checksum"}}
+				t.append(args[1], resp.ChecksumAlgorithm.String()+":"+resp.Checksum)
+				return c.print(cmd.OutOrStdout(), resp, t)
+			})
+		},
+	})
+	return cmd
+}
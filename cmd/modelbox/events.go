@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	client "github.com/tensorland/modelbox/sdk-go"
+)
+
+func (c *cli) eventsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "events",
+		Aliases: []string{"event"},
+		Short:   "Log and list the events of an object",
+	}
+	var since string
+	list := &cobra.Command{
+		Use:   "list <object-id>",
+		Short: "List the events of an object",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sinceTime, err := parseSince(since)
+			if err != nil {
+				return err
+			}
+			return c.run(cmd, func(ctx context.Context, mb *client.ModelBoxClient) error {
+				events, err := mb.ListEvents(ctx, args[0], sinceTime)
+				if err != nil {
+					return err
+				}
+				t := &table{header: []string{"time", "name", "source", "metadata"}}
+				for _, e := range events {
+					metadata := make([]string, 0, len(e.Metadata))
+					for k, v := range e.Metadata {
+						metadata = append(metadata, k+"="+v)
+					}
+					sort.Strings(metadata)
+					t.append(formatTime(e.WallclockTime), e.Name, e.Source, strings.Join(metadata, " "))
+				}
+				return c.print(cmd.OutOrStdout(), events, t)
+			})
+		},
+	}
+	list.Flags().StringVar(&since, "since", "", "only list events after a time (RFC 3339) or a duration ago, e.g. 24h")
+	cmd.AddCommand(list)
+
+	var source string
+	var metadata map[string]string
+	log := &cobra.Command{
+		Use:   "log <object-id> <name>",
+		Short: "Log an event for an object",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.run(cmd, func(ctx context.Context, mb *client.ModelBoxClient) error {
+				resp, err := mb.LogEvent(ctx, args[0], &client.Event{
+					Name:          args[1],
+					Source:        source,
+					WallclockTime: time.Now(),
+					Metadata:      metadata,
+				})
+				if err != nil {
+					return err
+				}
+				t := &table{header: []string{"created at"}}
+				t.append(formatTime(resp.CreatedAt))
+				return c.print(cmd.OutOrStdout(), resp, t)
+			})
+		},
+	}
+	log.Flags().StringVar(&source, "source", "modelbox-cli", "system which reports the event")
+	log.Flags().StringToStringVar(&metadata, "meta", nil, "metadata of the event as key=value, can be repeated")
+	cmd.AddCommand(log)
+	return cmd
+}
+
+// parseSince parses a time or a duration before now, an empty string is the
+// zero time.
+func parseSince(since string) (time.Time, error) {
+	if since == "" {
+		return time.Time{}, nil
+	}
+	if d, err := time.ParseDuration(since); err == nil {
+		return time.Now().Add(-d), nil
+	}
+	t, err := time.Parse(time.RFC3339, since)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q, expected RFC 3339 or a duration", since)
+	}
+	return t, nil
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	client "github.com/tensorland/modelbox/sdk-go"
+)
+
+func (c *cli) experimentsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "experiments",
+		Aliases: []string{"experiment", "exp"},
+		Short:   "Create and list experiments",
+	}
+	cmd.AddCommand(&cobra.Command{
+		Use:   "list",
+		Short: "List the experiments of a namespace",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.run(cmd, func(ctx context.Context, mb *client.ModelBoxClient) error {
+				experiments, err := mb.ListExperiments(ctx, c.namespace)
+				if err != nil {
+					return err
+				}
+				return c.print(cmd.OutOrStdout(), experiments, experimentsTable(experiments...))
+			})
+		},
+	})
+	cmd.AddCommand(&cobra.Command{
+		Use:   "get <experiment-id>",
+		Short: "Show an experiment",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.run(cmd, func(ctx context.Context, mb *client.ModelBoxClient) error {
+				experiment, err := mb.GetExperiment(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				return c.print(cmd.OutOrStdout(), experiment, experimentsTable(experiment))
+			})
+		},
+	})
+	cmd.AddCommand(&cobra.Command{
+		Use:   "search <query>",
+		Short: "Search experiments by their fields, metadata and metrics",
+		Long: `Search the experiments of a namespace, e.g.
+
+  modelbox experiments search "max(metrics.val/acc) > 0.9 and metadata.lr < 1e-3"
+
+Results show the latest values of the metrics the query refers to.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.run(cmd, func(ctx context.Context, mb *client.ModelBoxClient) error {
+				results, err := mb.Search(ctx, c.namespace, args[0])
+				if err != nil {
+					return err
+				}
+				t := &table{header: []string{"id", "name", "owner", "created at", "metrics"}}
+				for _, r := range results {
+					keys := make([]string, 0, len(r.Metrics))
+					for key := range r.Metrics {
+						keys = append(keys, key)
+					}
+					sort.Strings(keys)
+					metrics := make([]string, 0, len(keys))
+					for _, key := range keys {
+						metrics = append(metrics, fmt.Sprintf("%v=%v", key, r.Metrics[key].Latest))
+					}
+					e := r.Experiment
+					t.append(e.Id, e.Name, e.Owner, formatTime(e.CreatedAt), strings.Join(metrics, " "))
+				}
+				return c.print(cmd.OutOrStdout(), results, t)
+			})
+		},
+	})
+
+	var owner, framework, externalId string
+	create := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create an experiment, or return the existing one with the same name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.run(cmd, func(ctx context.Context, mb *client.ModelBoxClient) error {
+				resp, err := mb.CreateExperiment(ctx, args[0], owner, c.namespace, externalId, framework)
+				if err != nil {
+					return err
+				}
+				t := &table{header: []string{"id", "exists", "created at"}}
+				t.append(resp.Id, boolStr(resp.Exists), formatTime(resp.CreatedAt))
+				return c.print(cmd.OutOrStdout(), resp, t)
+			})
+		},
+	}
+	create.Flags().StringVar(&owner, "owner", "", "owner of the experiment, defaults to the owner of the client config")
+	create.Flags().StringVar(&framework, "framework", "", "ML framework, e.g. pytorch or keras")
+	create.Flags().StringVar(&externalId, "external-id", "", "id of the experiment in another system")
+	cmd.AddCommand(create)
+	return cmd
+}
+
+func experimentsTable(experiments ...*client.Experiment) *table {
+	t := &table{header: []string{"id", "name", "owner", "namespace", "framework", "created at"}}
+	for _, e := range experiments {
+		t.append(e.Id, e.Name, e.Owner, e.Namespace, e.Framework, formatTime(e.CreatedAt))
+	}
+	return t
+}
+
+func boolStr(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
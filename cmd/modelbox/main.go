@@ -0,0 +1,91 @@
+// Command modelbox is the command line client of ModelBox. It reads the
+// client config the same way as the SDK, and prints results as tables or,
+// for scripting, as JSON or YAML.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	client "github.com/tensorland/modelbox/sdk-go"
+)
+
+// cli holds the global flags shared by every command.
+type cli struct {
+	configPath string
+	profile    string
+	serverAddr string
+	namespace  string
+	output     string
+	// clientOpts are added to the options of the client config, tests use
+	// them to dial in-memory servers.
+	clientOpts []client.ClientOption
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if err := newRootCmd(&cli{}).ExecuteContext(ctx); err != nil {
+		os.Exit(1)
+	}
+}
+
+func newRootCmd(c *cli) *cobra.Command {
+	root := &cobra.Command{
+		Use:          "modelbox",
+		Short:        "Manage experiments, models and their artifacts in ModelBox",
+		SilenceUsage: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			switch c.output {
+			case OUTPUT_TABLE, OUTPUT_JSON, OUTPUT_YAML:
+				return nil
+			}
+			return fmt.Errorf("unknown output format %q, expected table, json or yaml", c.output)
+		},
+	}
+	flags := root.PersistentFlags()
+	flags.StringVar(&c.configPath, "config", "", "path of the client config")
+	flags.StringVar(&c.profile, "profile", "", "client config profile")
+	flags.StringVar(&c.serverAddr, "server-addr", "", "address of the server, overrides the client config")
+	flags.StringVarP(&c.namespace, "namespace", "n", "", "namespace, defaults to the namespace of the client config")
+	flags.StringVarP(&c.output, "output", "o", OUTPUT_TABLE, "output format: table, json or yaml")
+
+	root.AddCommand(
+		c.experimentsCmd(),
+		c.modelsCmd(),
+		c.versionsCmd(),
+		c.artifactsCmd(),
+		c.metricsCmd(),
+		c.eventsCmd(),
+		c.metadataCmd(),
+		c.watchCmd(),
+		c.serverCmd(),
+	)
+	return root
+}
+
+// client connects to the server of the client config.
+func (c *cli) client() (*client.ModelBoxClient, error) {
+	config, err := client.LoadClientConfig(c.configPath, c.profile)
+	if err != nil {
+		return nil, err
+	}
+	if c.serverAddr != "" {
+		config.ServerAddr = c.serverAddr
+	}
+	return client.NewModelBoxClient(config.ServerAddr, append(config.Options(), c.clientOpts...)...)
+}
+
+// run connects to the server and calls fn with the command's context.
+func (c *cli) run(cmd *cobra.Command, fn func(ctx context.Context, mb *client.ModelBoxClient) error) error {
+	mb, err := c.client()
+	if err != nil {
+		return err
+	}
+	defer mb.Close()
+	return fn(cmd.Context(), mb)
+}
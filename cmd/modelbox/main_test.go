@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	client "github.com/tensorland/modelbox/sdk-go"
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/yaml.v3"
+)
+
+type cliServer struct {
+	proto.UnimplementedModelStoreServer
+
+	metadata map[string]string
+}
+
+func (s *cliServer) ListExperiments(ctx context.Context, req *proto.ListExperimentsRequest) (*proto.ListExperimentsResponse, error) {
+	return &proto.ListExperimentsResponse{Experiments: []*proto.Experiment{{
+		Id:        "exp-1",
+		Name:      "bert",
+		Owner:     "owner@tensorland.ai",
+		Namespace: req.Namespace,
+		Framework: proto.MLFramework_PYTORCH,
+		CreatedAt: timestamppb.New(time.Date(2022, 10, 25, 10, 0, 0, 0, time.UTC)),
+	}}}, nil
+}
+
+func (s *cliServer) UpdateMetadata(ctx context.Context, req *proto.UpdateMetadataRequest) (*proto.UpdateMetadataResponse, error) {
+	for k, v := range req.Metadata.Metadata {
+		s.metadata[k] = v
+	}
+	return &proto.UpdateMetadataResponse{}, nil
+}
+
+func (s *cliServer) ListMetadata(ctx context.Context, req *proto.ListMetadataRequest) (*proto.ListMetadataResponse, error) {
+	return &proto.ListMetadataResponse{Metadata: &proto.Metadata{Metadata: s.metadata}}, nil
+}
+
+func (s *cliServer) GetMetrics(ctx context.Context, req *proto.GetMetricsRequest) (*proto.GetMetricsResponse, error) {
+	return &proto.GetMetricsResponse{Metrics: map[string]*proto.Metrics{
+		"val/acc": {Key: "val/acc", Values: []*proto.MetricsValue{{Step: 1, Value: &proto.MetricsValue_FVal{FVal: 0.93}}}},
+	}}, nil
+}
+
+func runCLI(t *testing.T, server *cliServer, args ...string) (string, error) {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	proto.RegisterModelStoreServer(s, server)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+	dialer := func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}
+	c := &cli{clientOpts: []client.ClientOption{client.WithDialOptions(grpc.WithContextDialer(dialer))}}
+	root := newRootCmd(c)
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetErr(&out)
+	root.SetArgs(append([]string{"--config", "assets/modelbox_client.yaml"}, args...))
+	err := root.ExecuteContext(context.Background())
+	return out.String(), err
+}
+
+func TestExperimentsList(t *testing.T) {
+	server := &cliServer{}
+
+	out, err := runCLI(t, server, "experiments", "list")
+	assert.Nil(t, err)
+	assert.Contains(t, out, "NAME")
+	assert.Contains(t, out, "bert")
+	assert.Contains(t, out, "pytorch")
+
+	out, err = runCLI(t, server, "experiments", "list", "-n", "research", "-o", "json")
+	assert.Nil(t, err)
+	var experiments []map[string]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(out), &experiments))
+	assert.Equal(t, "exp-1", experiments[0]["id"])
+	assert.Equal(t, "research", experiments[0]["namespace"])
+
+	out, err = runCLI(t, server, "experiments", "list", "-o", "yaml")
+	assert.Nil(t, err)
+	assert.Nil(t, yaml.Unmarshal([]byte(out), &experiments))
+	assert.Equal(t, "owner@tensorland.ai", experiments[0]["owner"])
+	assert.Equal(t, "default", experiments[0]["namespace"])
+}
+
+func TestExperimentsSearch(t *testing.T) {
+	server := &cliServer{metadata: map[string]string{"lr": "0.001"}}
+	out, err := runCLI(t, server, "experiments", "search", "metrics.val/acc > 0.9 and metadata.lr < 1e-2")
+	assert.Nil(t, err)
+	assert.Contains(t, out, "exp-1")
+	assert.Contains(t, out, "val/acc=0.93")
+
+	out, err = runCLI(t, server, "experiments", "search", "metadata.lr > 1")
+	assert.Nil(t, err)
+	assert.NotContains(t, out, "exp-1")
+}
+
+func TestUnknownOutputFormat(t *testing.T) {
+	_, err := runCLI(t, &cliServer{}, "experiments", "list", "-o", "xml")
+	assert.ErrorContains(t, err, "unknown output format")
+}
+
+func TestMetadataSet(t *testing.T) {
+	server := &cliServer{metadata: map[string]string{}}
+	_, err := runCLI(t, server, "metadata", "set", "exp-1", "lr=0.01", "optimizer=adam", "layers=[1,2]")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"lr": "0.01", "optimizer": `"adam"`, "layers": "[1,2]"}, server.metadata)
+
+	_, err = runCLI(t, server, "metadata", "set", "exp-1", "invalid")
+	assert.ErrorContains(t, err, "expected key=value")
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	client "github.com/tensorland/modelbox/sdk-go"
+)
+
+func (c *cli) metadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "metadata",
+		Short: "Show and update the metadata of an object",
+	}
+	cmd.AddCommand(&cobra.Command{
+		Use:   "get <object-id>",
+		Short: "Show the metadata of an object",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.run(cmd, func(ctx context.Context, mb *client.ModelBoxClient) error {
+				metadata, err := mb.ListMetadata(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				keys := make([]string, 0, len(metadata))
+				for k := range metadata {
+					keys = append(keys, k)
+				}
+				sort.Strings(keys)
+				t := &table{header: []string{"key", "value"}}
+				for _, k := range keys {
+					b, _ := json.Marshal(metadata[k])
+					t.append(k, string(b))
+				}
+				return c.print(cmd.OutOrStdout(), metadata, t)
+			})
+		},
+	})
+	cmd.AddCommand(&cobra.Command{
+		Use:   "set <object-id> <key=value>...",
+		Short: "Set metadata of an object, values are parsed as JSON when they are valid JSON",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			metadata, err := parseMetadata(args[1:])
+			if err != nil {
+				return err
+			}
+			return c.run(cmd, func(ctx context.Context, mb *client.ModelBoxClient) error {
+				return mb.UpdateMetadata(ctx, args[0], metadata)
+			})
+		},
+	})
+	return cmd
+}
+
+func parseMetadata(pairs []string) (map[string]interface{}, error) {
+	metadata := make(map[string]interface{}, len(pairs))
+	for _, pair := range pairs {
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok || k == "" {
+			return nil, fmt.Errorf("invalid metadata %q, expected key=value", pair)
+		}
+		var value interface{}
+		if err := json.Unmarshal([]byte(v), &value); err != nil {
+			value = v
+		}
+		metadata[k] = value
+	}
+	return metadata, nil
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	client "github.com/tensorland/modelbox/sdk-go"
+)
+
+func (c *cli) metricsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "metrics",
+		Aliases: []string{"metric"},
+		Short:   "Log and show the metrics of an object",
+	}
+	cmd.AddCommand(&cobra.Command{
+		Use:   "get <object-id>",
+		Short: "Show every value logged for the metrics of an object",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.run(cmd, func(ctx context.Context, mb *client.ModelBoxClient) error {
+				metrics, err := mb.GetMetrics(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				keys := make([]string, 0, len(metrics))
+				for key := range metrics {
+					keys = append(keys, key)
+				}
+				sort.Strings(keys)
+				t := &table{header: []string{"metric", "step", "time", "value"}}
+				for _, key := range keys {
+					for _, v := range metrics[key] {
+						wallclock := time.Unix(int64(v.WallclockTime), 0)
+						t.append(key, strconv.FormatUint(v.Step, 10), formatTime(wallclock), formatMetricValue(v.Value))
+					}
+				}
+				return c.print(cmd.OutOrStdout(), metrics, t)
+			})
+		},
+	})
+
+	var step uint64
+	log := &cobra.Command{
+		Use:   "log <object-id> <metric> <value>",
+		Short: "Log a scalar value of a metric",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			value, err := strconv.ParseFloat(args[2], 32)
+			if err != nil {
+				return fmt.Errorf("invalid metric value %q: %v", args[2], err)
+			}
+			return c.run(cmd, func(ctx context.Context, mb *client.ModelBoxClient) error {
+				return mb.LogMetrics(ctx, args[0], args[1], &client.MetricValue{
+					Step:          step,
+					WallclockTime: uint64(time.Now().Unix()),
+					Value:         float32(value),
+				})
+			})
+		},
+	}
+	log.Flags().Uint64Var(&step, "step", 0, "step the value was measured at")
+	cmd.AddCommand(log)
+	return cmd
+}
+
+func formatMetricValue(v interface{}) string {
+	if b, ok := v.([]byte); ok {
+		return fmt.Sprintf("<%v bytes>", len(b))
+	}
+	return fmt.Sprint(v)
+}
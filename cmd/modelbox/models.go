@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	client "github.com/tensorland/modelbox/sdk-go"
+)
+
+func (c *cli) modelsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "models",
+		Aliases: []string{"model"},
+		Short:   "Create and list models",
+	}
+	cmd.AddCommand(&cobra.Command{
+		Use:   "list",
+		Short: "List the models of a namespace",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.run(cmd, func(ctx context.Context, mb *client.ModelBoxClient) error {
+				models, err := mb.ListModels(ctx, c.namespace)
+				if err != nil {
+					return err
+				}
+				t := &table{header: []string{"id", "name", "owner", "task", "created at"}}
+				for _, m := range models {
+					t.append(m.Id, m.Name, m.Owner, m.Task, formatTime(m.CreatedAt))
+				}
+				return c.print(cmd.OutOrStdout(), models, t)
+			})
+		},
+	})
+
+	var owner, task, description string
+	create := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a model, or return the existing one with the same name",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.run(cmd, func(ctx context.Context, mb *client.ModelBoxClient) error {
+				resp, err := mb.CreateModel(ctx, args[0], owner, c.namespace, task, description)
+				if err != nil {
+					return err
+				}
+				t := &table{header: []string{"id", "exists"}}
+				t.append(resp.Id, boolStr(resp.Exists))
+				return c.print(cmd.OutOrStdout(), resp, t)
+			})
+		},
+	}
+	create.Flags().StringVar(&owner, "owner", "", "owner of the model, defaults to the owner of the client config")
+	create.Flags().StringVar(&task, "task", "", "task solved by the model")
+	create.Flags().StringVar(&description, "description", "", "description of the model")
+	cmd.AddCommand(create)
+	return cmd
+}
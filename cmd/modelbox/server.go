@@ -0,0 +1,54 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/tensorland/modelbox/server"
+	"go.uber.org/zap"
+)
+
+func (c *cli) serverCmd() *cobra.Command {
+	var embedded bool
+	config := &server.Config{}
+	cmd := &cobra.Command{
+		Use:   "server",
+		Short: "Run a ModelBox server",
+		Long: "Run an embedded ModelBox server which keeps its data in a SQLite database and a\n" +
+			"directory of the local filesystem, for laptops and CI. Production deployments run\n" +
+			"the Rust server instead.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !embedded {
+				return errors.New("only the embedded server is built in, pass --embedded or run the Rust server")
+			}
+			if config.DataDir == "" {
+				home, err := os.UserHomeDir()
+				if err != nil {
+					return fmt.Errorf("unable to find the default data directory: %v", err)
+				}
+				config.DataDir = filepath.Join(home, ".modelbox", "data")
+			}
+			logger, err := zap.NewProduction()
+			if err != nil {
+				return fmt.Errorf("unable to create logger: %v", err)
+			}
+			defer logger.Sync()
+			s, err := server.New(config, logger)
+			if err != nil {
+				return err
+			}
+			defer s.Close()
+			return s.ListenAndServe(cmd.Context())
+		},
+	}
+	flags := cmd.Flags()
+	flags.BoolVar(&embedded, "embedded", false, "run the embedded server backed by SQLite and the local filesystem")
+	flags.StringVar(&config.GrpcListenAddr, "listen-addr", server.DEFAULT_GRPC_LISTEN_ADDR, "address the grpc server listens on")
+	flags.StringVar(&config.HTTPListenAddr, "http-addr", "", "address serving /healthz, disabled when empty")
+	flags.StringVar(&config.DataDir, "data-dir", "", "directory of the database and the files, defaults to ~/.modelbox/data")
+	return cmd
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/spf13/cobra"
+	client "github.com/tensorland/modelbox/sdk-go"
+)
+
+func (c *cli) versionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "versions",
+		Aliases: []string{"version"},
+		Short:   "Create and list the versions of a model",
+	}
+	cmd.AddCommand(&cobra.Command{
+		Use:   "list <model-id>",
+		Short: "List the versions of a model",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.run(cmd, func(ctx context.Context, mb *client.ModelBoxClient) error {
+				versions, err := mb.ListModelVersions(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				t := &table{header: []string{"id", "name", "version", "framework", "tags", "created at"}}
+				for _, v := range versions {
+					t.append(v.Id, v.Name, v.Version, v.Framework, strings.Join(v.UniqueTags, ","), formatTime(v.CreatedAt))
+				}
+				return c.print(cmd.OutOrStdout(), versions, t)
+			})
+		},
+	})
+
+	var name, description, framework string
+	var tags []string
+	create := &cobra.Command{
+		Use:   "create <model-id> <version>",
+		Short: "Create a version of a model",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.run(cmd, func(ctx context.Context, mb *client.ModelBoxClient) error {
+				resp, err := mb.CreateModelVersion(ctx, args[0], name, args[1], description, c.namespace, framework, tags)
+				if err != nil {
+					return err
+				}
+				t := &table{header: []string{"id", "exists", "created at"}}
+				t.append(resp.Id, boolStr(resp.Exists), formatTime(resp.CreatedAt))
+				return c.print(cmd.OutOrStdout(), resp, t)
+			})
+		},
+	}
+	create.Flags().StringVar(&name, "name", "", "name of the version")
+	create.Flags().StringVar(&description, "description", "", "description of the version")
+	create.Flags().StringVar(&framework, "framework", "", "ML framework, e.g. pytorch or keras")
+	create.Flags().StringSliceVar(&tags, "tag", nil, "unique tag of the version, can be repeated")
+	cmd.AddCommand(create)
+	return cmd
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	client "github.com/tensorland/modelbox/sdk-go"
+)
+
+// watchEvent is the output of watch, the payload is the decoded object or
+// the raw payload when it couldn't be decoded.
+type watchEvent struct {
+	Position uint64                 `json:"position"`
+	Type     client.ChangeEventType `json:"type"`
+	Kind     client.ObjectKind      `json:"kind"`
+	ObjectId string                 `json:"object_id"`
+	Object   interface{}            `json:"object"`
+}
+
+func (c *cli) watchCmd() *cobra.Command {
+	var kinds, events []string
+	var owner, framework, nameGlob, since string
+	var afterPosition uint64
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Stream the changes of a namespace until interrupted",
+		Long: "Stream the changes of a namespace until interrupted. Tables are printed a row per\n" +
+			"change, JSON a line per change and YAML a document per change.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var opts []client.WatchOption
+			for _, k := range kinds {
+				kind, err := client.ObjectKindFromStr(k)
+				if err != nil {
+					return err
+				}
+				opts = append(opts, client.WatchObjectKinds(kind))
+			}
+			for _, e := range events {
+				t, err := client.ChangeEventTypeFromStr(e)
+				if err != nil {
+					return err
+				}
+				opts = append(opts, client.WatchEventTypes(t))
+			}
+			if owner != "" {
+				opts = append(opts, client.WatchOwner(owner))
+			}
+			if framework != "" {
+				opts = append(opts, client.WatchFramework(framework))
+			}
+			if nameGlob != "" {
+				opts = append(opts, client.WatchNameGlob(nameGlob))
+			}
+			if since != "" {
+				sinceTime, err := parseSince(since)
+				if err != nil {
+					return err
+				}
+				opts = append(opts, client.WatchSince(sinceTime))
+			}
+			if afterPosition > 0 {
+				opts = append(opts, client.WatchAfterPosition(afterPosition))
+			}
+			return c.run(cmd, func(ctx context.Context, mb *client.ModelBoxClient) error {
+				w := mb.Watch(ctx, c.namespace, opts...)
+				defer w.Close()
+				for event := range w.Events() {
+					if err := c.printWatchEvent(cmd, event); err != nil {
+						return err
+					}
+				}
+				return w.Err()
+			})
+		},
+	}
+	flags := cmd.Flags()
+	flags.StringSliceVar(&kinds, "kind", nil, "only show changes of objects of a kind: experiment, model, model_version, artifact or metadata")
+	flags.StringSliceVar(&events, "event", nil, "only show a type of change: created or updated")
+	flags.StringVar(&owner, "owner", "", "only show changes of objects owned by owner")
+	flags.StringVar(&framework, "framework", "", "only show changes of objects using an ML framework")
+	flags.StringVar(&nameGlob, "name", "", "only show changes of objects whose name matches a glob")
+	flags.StringVar(&since, "since", "", "show changes after a time (RFC 3339) or a duration ago instead of from now")
+	flags.Uint64Var(&afterPosition, "after-position", 0, "show the changes after a position, e.g. the last one shown by a previous watch")
+	return cmd
+}
+
+func (c *cli) printWatchEvent(cmd *cobra.Command, event *client.ChangeEvent) error {
+	out := cmd.OutOrStdout()
+	v := &watchEvent{
+		Position: event.Position,
+		Type:     event.Type,
+		Kind:     event.Kind,
+		ObjectId: event.ObjectId,
+		Object:   event.Object,
+	}
+	if event.Object == nil && event.Payload != nil {
+		v.Object = event.Payload.AsInterface()
+	}
+	switch c.output {
+	case OUTPUT_JSON:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(b))
+		return err
+	case OUTPUT_YAML:
+		b, err := toYAML(v)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(out, "---\n%s", b)
+		return err
+	}
+	_, err := fmt.Fprintf(out, "%-10v %-8v %-14v %v\n", event.Position, event.Type, event.Kind, event.ObjectId)
+	return err
+}
@@ -15,6 +15,7 @@ pub struct Model {
     pub double_value: Option<f64>,
     pub step: Option<i64>,
     pub wall_clock: Option<TimeDateTime>,
+    pub idempotency_key: Option<String>,
     pub created_at: TimeDateTime,
 }
 
@@ -0,0 +1,209 @@
+// Package changelog defines the change log entries served by WatchNamespace
+// for the servers of this module. Payloads mirror the JSON encoding of
+// objects by the ModelBox server, which the SDK decodes into ChangeEvents.
+package changelog
+
+import (
+	"encoding/json"
+	"path"
+	"time"
+
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ExperimentPayload struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	ExternalId  string `json:"external_id"`
+	Owner       string `json:"owner"`
+	Namespace   string `json:"namespace"`
+	MLFramework int32  `json:"ml_framework"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+type ModelPayload struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Owner       string `json:"owner"`
+	Namespace   string `json:"namespace"`
+	Task        string `json:"task"`
+	Description string `json:"description"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+type ModelVersionPayload struct {
+	Id          string   `json:"id"`
+	Name        string   `json:"name"`
+	ModelId     string   `json:"model_id"`
+	Namespace   string   `json:"namespace"`
+	Version     string   `json:"version"`
+	Description string   `json:"description"`
+	MLFramework int32    `json:"ml_framework"`
+	UniqueTags  []string `json:"unique_tags"`
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
+}
+
+type FilePayload struct {
+	Id                string `json:"id"`
+	ParentId          string `json:"parent_id"`
+	FileType          int32  `json:"file_type"`
+	Checksum          string `json:"checksum"`
+	ChecksumAlgorithm int32  `json:"checksum_algorithm"`
+	SrcPath           string `json:"src_path"`
+	UploadPath        string `json:"upload_path"`
+	Size              uint64 `json:"size"`
+	CreatedAt         string `json:"created_at"`
+	UpdatedAt         string `json:"updated_at"`
+}
+
+type ArtifactPayload struct {
+	Id       string         `json:"id"`
+	Name     string         `json:"name"`
+	ObjectId string         `json:"object_id"`
+	Files    []*FilePayload `json:"files"`
+}
+
+type MetadataPayload struct {
+	ObjectId string                 `json:"object_id"`
+	Metadata map[string]interface{} `json:"metadata"`
+}
+
+func payloadTime(ts *timestamppb.Timestamp) string {
+	return ts.AsTime().Format(time.RFC3339Nano)
+}
+
+func NewExperimentPayload(e *proto.Experiment) *ExperimentPayload {
+	return &ExperimentPayload{
+		Id:          e.Id,
+		Name:        e.Name,
+		ExternalId:  e.ExternalId,
+		Owner:       e.Owner,
+		Namespace:   e.Namespace,
+		MLFramework: int32(e.Framework),
+		CreatedAt:   payloadTime(e.CreatedAt),
+		UpdatedAt:   payloadTime(e.UpdatedAt),
+	}
+}
+
+func NewModelPayload(m *proto.Model) *ModelPayload {
+	return &ModelPayload{
+		Id:          m.Id,
+		Name:        m.Name,
+		Owner:       m.Owner,
+		Namespace:   m.Namespace,
+		Task:        m.Task,
+		Description: m.Description,
+		CreatedAt:   payloadTime(m.CreatedAt),
+		UpdatedAt:   payloadTime(m.UpdatedAt),
+	}
+}
+
+// NewModelVersionPayload encodes a model version, which belongs to the
+// namespace of its model.
+func NewModelVersionPayload(v *proto.ModelVersion, namespace string) *ModelVersionPayload {
+	return &ModelVersionPayload{
+		Id:          v.Id,
+		Name:        v.Name,
+		ModelId:     v.ModelId,
+		Namespace:   namespace,
+		Version:     v.Version,
+		Description: v.Description,
+		MLFramework: int32(v.Framework),
+		UniqueTags:  v.UniqueTags,
+		CreatedAt:   payloadTime(v.CreatedAt),
+		UpdatedAt:   payloadTime(v.UpdatedAt),
+	}
+}
+
+func NewArtifactPayload(a *proto.Artifact) *ArtifactPayload {
+	payload := &ArtifactPayload{Id: a.Id, Name: a.Name, ObjectId: a.ObjectId}
+	for _, f := range a.Files {
+		payload.Files = append(payload.Files, &FilePayload{
+			Id:                f.Id,
+			ParentId:          f.ParentId,
+			FileType:          int32(f.FileType),
+			Checksum:          f.Checksum,
+			ChecksumAlgorithm: int32(f.ChecksumAlgorithm),
+			SrcPath:           f.SrcPath,
+			UploadPath:        f.UploadPath,
+			Size:              f.Size,
+			CreatedAt:         payloadTime(f.CreatedAt),
+			UpdatedAt:         payloadTime(f.UpdatedAt),
+		})
+	}
+	return payload
+}
+
+// NewMetadataPayload encodes the metadata of an object. Values encoded as
+// JSON by the SDKs are decoded.
+func NewMetadataPayload(objectId string, meta map[string]string) *MetadataPayload {
+	payload := &MetadataPayload{ObjectId: objectId, Metadata: make(map[string]interface{}, len(meta))}
+	for k, v := range meta {
+		var decoded interface{}
+		if err := json.Unmarshal([]byte(v), &decoded); err != nil {
+			decoded = v
+		}
+		payload.Metadata[k] = decoded
+	}
+	return payload
+}
+
+// Entry holds the fields of a change which watches filter on. The name,
+// owner and framework of changes to artifacts and metadata are the ones of
+// the object they belong to, except for the name of artifacts.
+type Entry struct {
+	Time      time.Time
+	Kind      proto.ObjectKind
+	Event     proto.ChangeEvent
+	Name      string
+	Owner     string
+	Framework proto.MLFramework
+}
+
+// Matches reports whether a change of the watched namespace passes the
+// filters of a watch.
+func (e *Entry) Matches(req *proto.WatchNamespaceRequest) bool {
+	if req.AfterPosition == 0 && e.Time.Unix() < int64(req.Since) {
+		return false
+	}
+	if len(req.ObjectKinds) > 0 && !containsKind(req.ObjectKinds, e.Kind) {
+		return false
+	}
+	if len(req.Events) > 0 && !containsEvent(req.Events, e.Event) {
+		return false
+	}
+	if req.Owner != "" && e.Owner != req.Owner {
+		return false
+	}
+	if req.MlFramework != proto.MLFramework_UNKNOWN && e.Framework != req.MlFramework {
+		return false
+	}
+	if req.NameGlob != "" {
+		if ok, _ := path.Match(req.NameGlob, e.Name); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func containsKind(kinds []proto.ObjectKind, kind proto.ObjectKind) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func containsEvent(events []proto.ChangeEvent, event proto.ChangeEvent) bool {
+	for _, e := range events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
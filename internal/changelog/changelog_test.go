@@ -0,0 +1,40 @@
+package changelog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tensorland/modelbox/sdk-go/proto"
+)
+
+func TestEntryMatches(t *testing.T) {
+	e := &Entry{
+		Time:      time.Unix(100, 0),
+		Kind:      proto.ObjectKind_OBJECT_KIND_MODEL,
+		Event:     proto.ChangeEvent_OBJECT_CREATED,
+		Name:      "asr-en",
+		Owner:     "owner@tensorland.ai",
+		Framework: proto.MLFramework_PYTORCH,
+	}
+	assert.True(t, e.Matches(&proto.WatchNamespaceRequest{}))
+	assert.True(t, e.Matches(&proto.WatchNamespaceRequest{
+		Since:       100,
+		ObjectKinds: []proto.ObjectKind{proto.ObjectKind_OBJECT_KIND_MODEL},
+		Events:      []proto.ChangeEvent{proto.ChangeEvent_OBJECT_CREATED},
+		Owner:       "owner@tensorland.ai",
+		MlFramework: proto.MLFramework_PYTORCH,
+		NameGlob:    "asr-*",
+	}))
+	assert.False(t, e.Matches(&proto.WatchNamespaceRequest{Since: 101}))
+	// positions take precedence over times
+	assert.True(t, e.Matches(&proto.WatchNamespaceRequest{Since: 101, AfterPosition: 1}))
+	assert.False(t, e.Matches(&proto.WatchNamespaceRequest{ObjectKinds: []proto.ObjectKind{proto.ObjectKind_OBJECT_KIND_EXPERIMENT}}))
+	assert.False(t, e.Matches(&proto.WatchNamespaceRequest{Events: []proto.ChangeEvent{proto.ChangeEvent_OBJECT_UPDATED}}))
+	assert.False(t, e.Matches(&proto.WatchNamespaceRequest{NameGlob: "tts-*"}))
+}
+
+func TestNewMetadataPayload(t *testing.T) {
+	payload := NewMetadataPayload("exp-1", map[string]string{"layers": "3", "name": "not json"})
+	assert.Equal(t, map[string]interface{}{"layers": 3.0, "name": "not json"}, payload.Metadata)
+}
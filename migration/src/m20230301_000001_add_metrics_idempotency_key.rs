@@ -0,0 +1,58 @@
+use sea_orm_migration::prelude::*;
+
+#[derive(DeriveMigrationName)]
+pub struct Migration;
+
+#[async_trait::async_trait]
+impl MigrationTrait for Migration {
+    async fn up(&self, manager: &SchemaManager) -> Result<(), DbErr> {
+        manager
+            .alter_table(
+                Table::alter()
+                    .table(Metrics::Table)
+                    .add_column(ColumnDef::new(Metrics::IdempotencyKey).string_len(64))
+                    .to_owned(),
+            )
+            .await?;
+
+        // rows without a key are never equal, so only retried calls conflict
+        manager
+            .create_index(
+                Index::create()
+                    .name("metrics_idempotency_key")
+                    .table(Metrics::Table)
+                    .col(Metrics::ObjectId)
+                    .col(Metrics::IdempotencyKey)
+                    .unique()
+                    .to_owned(),
+            )
+            .await
+    }
+
+    async fn down(&self, manager: &SchemaManager) -> Result<(), DbErr> {
+        manager
+            .drop_index(
+                Index::drop()
+                    .name("metrics_idempotency_key")
+                    .table(Metrics::Table)
+                    .to_owned(),
+            )
+            .await?;
+        manager
+            .alter_table(
+                Table::alter()
+                    .table(Metrics::Table)
+                    .drop_column(Metrics::IdempotencyKey)
+                    .to_owned(),
+            )
+            .await
+    }
+}
+
+#[derive(Iden)]
+enum Metrics {
+    #[iden = "metrics"]
+    Table,
+    ObjectId,
+    IdempotencyKey,
+}
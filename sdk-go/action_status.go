@@ -0,0 +1,232 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/tensorland/modelbox/sdk-go/proto"
+)
+
+// ActionStatus is the lifecycle state of an action instance. Instances are
+// pending until an agent starts running them, and end up succeeded, failed
+// or cancelled.
+type ActionStatus uint32
+
+const (
+	ActionStatusUnknown ActionStatus = iota
+	ActionStatusPending
+	ActionStatusRunning
+	ActionStatusSucceeded
+	ActionStatusFailed
+	ActionStatusCancelled
+)
+
+// actionTransitions lists the statuses each status can move to. Running
+// instances report progress by moving to running again.
+var actionTransitions = map[ActionStatus][]ActionStatus{
+	ActionStatusUnknown: {ActionStatusPending, ActionStatusRunning, ActionStatusCancelled},
+	ActionStatusPending: {ActionStatusRunning, ActionStatusFailed, ActionStatusCancelled},
+	ActionStatusRunning: {ActionStatusRunning, ActionStatusSucceeded, ActionStatusFailed, ActionStatusCancelled},
+}
+
+// CanTransitionTo reports whether an instance with status s can move to
+// next.
+func (s ActionStatus) CanTransitionTo(next ActionStatus) bool {
+	for _, status := range actionTransitions[s] {
+		if status == next {
+			return true
+		}
+	}
+	return false
+}
+
+// Done reports whether the instance has stopped for good.
+func (s ActionStatus) Done() bool {
+	return s == ActionStatusSucceeded || s == ActionStatusFailed || s == ActionStatusCancelled
+}
+
+// Outcome returns the outcome of an instance with status s.
+func (s ActionStatus) Outcome() ActionOutcome {
+	switch s {
+	case ActionStatusSucceeded:
+		return ActionOutcomeSuccess
+	case ActionStatusFailed, ActionStatusCancelled:
+		return ActionOutcomeFailure
+	}
+	return ActionOutcomeUnknown
+}
+
+func ActionStatusFromProto(s proto.ActionStatus) ActionStatus {
+	switch s {
+	case proto.ActionStatus_ACTION_STATUS_PENDING:
+		return ActionStatusPending
+	case proto.ActionStatus_ACTION_STATUS_RUNNING:
+		return ActionStatusRunning
+	case proto.ActionStatus_ACTION_STATUS_SUCCEEDED:
+		return ActionStatusSucceeded
+	case proto.ActionStatus_ACTION_STATUS_FAILED:
+		return ActionStatusFailed
+	case proto.ActionStatus_ACTION_STATUS_CANCELLED:
+		return ActionStatusCancelled
+	}
+	return ActionStatusUnknown
+}
+
+func (s ActionStatus) ToProto() proto.ActionStatus {
+	switch s {
+	case ActionStatusPending:
+		return proto.ActionStatus_ACTION_STATUS_PENDING
+	case ActionStatusRunning:
+		return proto.ActionStatus_ACTION_STATUS_RUNNING
+	case ActionStatusSucceeded:
+		return proto.ActionStatus_ACTION_STATUS_SUCCEEDED
+	case ActionStatusFailed:
+		return proto.ActionStatus_ACTION_STATUS_FAILED
+	case ActionStatusCancelled:
+		return proto.ActionStatus_ACTION_STATUS_CANCELLED
+	}
+	return proto.ActionStatus_ACTION_STATUS_UNKNOWN
+}
+
+func (s ActionStatus) String() string {
+	switch s {
+	case ActionStatusPending:
+		return "pending"
+	case ActionStatusRunning:
+		return "running"
+	case ActionStatusSucceeded:
+		return "succeeded"
+	case ActionStatusFailed:
+		return "failed"
+	case ActionStatusCancelled:
+		return "cancelled"
+	}
+	return "unknown"
+}
+
+// ActionOutcome is the result of an action instance which is done.
+type ActionOutcome uint32
+
+const (
+	ActionOutcomeUnknown ActionOutcome = iota
+	ActionOutcomeSuccess
+	ActionOutcomeFailure
+)
+
+func ActionOutcomeFromProto(o proto.ActionOutcome) ActionOutcome {
+	switch o {
+	case proto.ActionOutcome_ACTION_OUTCOME_SUCCESS:
+		return ActionOutcomeSuccess
+	case proto.ActionOutcome_ACTION_OUTCOME_FAILURE:
+		return ActionOutcomeFailure
+	}
+	return ActionOutcomeUnknown
+}
+
+func (o ActionOutcome) ToProto() proto.ActionOutcome {
+	switch o {
+	case ActionOutcomeSuccess:
+		return proto.ActionOutcome_ACTION_OUTCOME_SUCCESS
+	case ActionOutcomeFailure:
+		return proto.ActionOutcome_ACTION_OUTCOME_FAILURE
+	}
+	return proto.ActionOutcome_ACTION_OUTCOME_UNKNOWN
+}
+
+func (o ActionOutcome) String() string {
+	switch o {
+	case ActionOutcomeSuccess:
+		return "success"
+	case ActionOutcomeFailure:
+		return "failure"
+	}
+	return "unknown"
+}
+
+// ActionReporter reports the status of an action instance as it runs. It
+// tracks the status it reported and rejects illegal transitions, such as
+// reporting progress on an instance which failed, before they reach the
+// server.
+type ActionReporter struct {
+	admin      *AdminClient
+	instanceId string
+
+	mu       sync.Mutex
+	status   ActionStatus
+	progress uint32
+}
+
+// NewActionReporter creates a reporter for an instance which currently has
+// the given status, usually pending.
+func (a *AdminClient) NewActionReporter(instanceId string, status ActionStatus) *ActionReporter {
+	return &ActionReporter{admin: a, instanceId: instanceId, status: status}
+}
+
+// Status returns the last status reported.
+func (r *ActionReporter) Status() ActionStatus {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.status
+}
+
+// Start reports that the instance is running.
+func (r *ActionReporter) Start(ctx context.Context) error {
+	return r.update(ctx, ActionStatusRunning, "", 0)
+}
+
+// Report reports a progress message of the running instance.
+func (r *ActionReporter) Report(ctx context.Context, message string) error {
+	r.mu.Lock()
+	progress := r.progress
+	r.mu.Unlock()
+	return r.update(ctx, ActionStatusRunning, message, progress)
+}
+
+// ReportPercent reports the percentage of the running instance which is
+// done, along with a progress message.
+func (r *ActionReporter) ReportPercent(ctx context.Context, percent uint32, message string) error {
+	if percent > 100 {
+		return fmt.Errorf("invalid progress %v%%", percent)
+	}
+	return r.update(ctx, ActionStatusRunning, message, percent)
+}
+
+// Succeed reports that the instance succeeded.
+func (r *ActionReporter) Succeed(ctx context.Context) error {
+	return r.update(ctx, ActionStatusSucceeded, "", 100)
+}
+
+// Fail reports that the instance failed because of err.
+func (r *ActionReporter) Fail(ctx context.Context, err error) error {
+	return r.update(ctx, ActionStatusFailed, err.Error(), r.currentProgress())
+}
+
+// Cancel reports that the instance was cancelled before it was done.
+func (r *ActionReporter) Cancel(ctx context.Context, reason string) error {
+	return r.update(ctx, ActionStatusCancelled, reason, r.currentProgress())
+}
+
+func (r *ActionReporter) currentProgress() uint32 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.progress
+}
+
+func (r *ActionReporter) update(ctx context.Context, status ActionStatus, reason string, progress uint32) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.status.CanTransitionTo(status) {
+		return &ActionTransitionError{InstanceId: r.instanceId, From: r.status, To: status}
+	}
+	err := r.admin.UpdateActionStatus(ctx, r.instanceId, &ActionStatusUpdate{
+		Status:   status,
+		Reason:   reason,
+		Progress: progress,
+	})
+	if err != nil {
+		return err
+	}
+	r.status, r.progress = status, progress
+	return nil
+}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type statusServer struct {
+	proto.UnimplementedModelBoxAdminServer
+
+	requests []*proto.UpdateActionStatusRequest
+}
+
+func (s *statusServer) UpdateActionStatus(ctx context.Context, req *proto.UpdateActionStatusRequest) (*proto.UpdateActionStatusResponse, error) {
+	s.requests = append(s.requests, req)
+	return &proto.UpdateActionStatusResponse{}, nil
+}
+
+func newStatusTestClient(t *testing.T, server *statusServer) *AdminClient {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	proto.RegisterModelBoxAdminServer(s, server)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+	dialer := func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}
+	admin, err := NewAdminClient("bufnet", WithDialOptions(grpc.WithContextDialer(dialer)))
+	assert.Nil(t, err)
+	t.Cleanup(func() { admin.Close() })
+	return admin
+}
+
+func TestActionStatusTransitions(t *testing.T) {
+	assert.True(t, ActionStatusPending.CanTransitionTo(ActionStatusRunning))
+	assert.True(t, ActionStatusRunning.CanTransitionTo(ActionStatusRunning))
+	assert.True(t, ActionStatusRunning.CanTransitionTo(ActionStatusCancelled))
+	assert.False(t, ActionStatusPending.CanTransitionTo(ActionStatusSucceeded))
+	for _, done := range []ActionStatus{ActionStatusSucceeded, ActionStatusFailed, ActionStatusCancelled} {
+		assert.True(t, done.Done())
+		for status := ActionStatusUnknown; status <= ActionStatusCancelled; status++ {
+			assert.False(t, done.CanTransitionTo(status))
+		}
+		assert.Equal(t, done, ActionStatusFromProto(done.ToProto()))
+	}
+}
+
+func TestActionReporter(t *testing.T) {
+	server := &statusServer{}
+	admin := newStatusTestClient(t, server)
+	ctx := context.Background()
+
+	r := admin.NewActionReporter("instance-1", ActionStatusPending)
+	assert.True(t, errors.Is(r.Succeed(ctx), ErrInvalidTransition))
+	assert.Nil(t, r.Start(ctx))
+	assert.Nil(t, r.ReportPercent(ctx, 40, "epoch 2/5"))
+	assert.NotNil(t, r.ReportPercent(ctx, 140, ""))
+	assert.Nil(t, r.Report(ctx, "evaluating"))
+	assert.Nil(t, r.Fail(ctx, errors.New("out of memory")))
+	var transition *ActionTransitionError
+	assert.ErrorAs(t, r.Report(ctx, "still running"), &transition)
+	assert.Equal(t, ActionStatusFailed, transition.From)
+	assert.Equal(t, ActionStatusFailed, r.Status())
+
+	assert.Len(t, server.requests, 4)
+	progress := server.requests[2]
+	assert.Equal(t, proto.ActionStatus_ACTION_STATUS_RUNNING, progress.Status)
+	assert.Equal(t, uint32(40), progress.Progress)
+	assert.Equal(t, "evaluating", progress.OutcomeReason)
+	failed := server.requests[3]
+	assert.Equal(t, proto.ActionStatus_ACTION_STATUS_FAILED, failed.Status)
+	assert.Equal(t, proto.ActionOutcome_ACTION_OUTCOME_FAILURE, failed.Outcome)
+	assert.Equal(t, "out of memory", failed.OutcomeReason)
+	assert.NotZero(t, failed.UpdateTime)
+}
@@ -0,0 +1,171 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// NodeInfo describes the host an agent runs on.
+type NodeInfo struct {
+	HostName string
+	IPAddr   string
+	Arch     string
+}
+
+// ClusterMember is a ModelBox server which is part of the cluster.
+type ClusterMember struct {
+	Id       string
+	HostName string
+	RPCAddr  string
+	HTTPAddr string
+}
+
+// RunnableAction is an instance of an action which an agent can execute.
+type RunnableAction struct {
+	Id       string
+	ActionId string
+	Command  string
+	Params   map[string]interface{}
+	// ObjectId is the experiment, model or model version the action runs on.
+	ObjectId string
+}
+
+// AdminClient talks to the admin plane of ModelBox which is used by agents
+// and operators.
+type AdminClient struct {
+	conn   *grpc.ClientConn
+	client proto.ModelBoxAdminClient
+	opts   *clientOptions
+}
+
+func NewAdminClient(addr string, opts ...ClientOption) (*AdminClient, error) {
+	conn, o, err := dial(addr, opts...)
+	if err != nil {
+		return nil, err
+	}
+	client := proto.NewModelBoxAdminClient(conn)
+	return &AdminClient{conn: conn, client: client, opts: o}, nil
+}
+
+func (a *AdminClient) Close() error {
+	return a.conn.Close()
+}
+
+// RegisterAgent registers an agent running on a node and returns the id
+// assigned to the node by the server.
+func (a *AdminClient) RegisterAgent(ctx context.Context, name string, node *NodeInfo) (string, error) {
+	ctx, cancel := withDefaultDeadline(ctx, a.opts.timeout)
+	defer cancel()
+	req := &proto.RegisterAgentRequest{
+		AgentName: name,
+		NodeInfo: &proto.NodeInfo{
+			HostName: node.HostName,
+			IpAddr:   node.IPAddr,
+			Arch:     node.Arch,
+		},
+	}
+	resp, err := a.client.RegisterAgent(ctx, req)
+	if err != nil {
+		return "", wrapError("register agent", err)
+	}
+	return resp.NodeId, nil
+}
+
+func (a *AdminClient) Heartbeat(ctx context.Context, nodeId string) error {
+	ctx, cancel := withDefaultDeadline(ctx, a.opts.timeout)
+	defer cancel()
+	req := &proto.HeartbeatRequest{
+		NodeId: nodeId,
+		At:     timestamppb.Now(),
+	}
+	_, err := a.client.Heartbeat(ctx, req)
+	return wrapError("send heartbeat", err)
+}
+
+// GetRunnableActions returns the instances of an action which are ready to
+// run on the given architecture.
+func (a *AdminClient) GetRunnableActions(ctx context.Context, actionName, arch string) ([]*RunnableAction, error) {
+	ctx, cancel := withDefaultDeadline(ctx, a.opts.timeout)
+	defer cancel()
+	req := &proto.GetRunnableActionInstancesRequest{
+		ActionName: actionName,
+		Arch:       arch,
+	}
+	resp, err := a.client.GetRunnableActionInstances(ctx, req)
+	if err != nil {
+		return nil, wrapError("get runnable actions", err)
+	}
+	actions := make([]*RunnableAction, 0, len(resp.Instances))
+	for _, instance := range resp.Instances {
+		params := make(map[string]interface{}, len(instance.Params))
+		for k, v := range instance.Params {
+			params[k] = v.AsInterface()
+		}
+		actions = append(actions, &RunnableAction{
+			Id:       instance.Id,
+			ActionId: instance.ActionId,
+			Command:  instance.Command,
+			Params:   params,
+			ObjectId: instance.ObjectId,
+		})
+	}
+	return actions, nil
+}
+
+// ActionStatusUpdate is reported by UpdateActionStatus.
+type ActionStatusUpdate struct {
+	Status ActionStatus
+	// Outcome defaults to the outcome of Status once the action is done.
+	Outcome ActionOutcome
+	// Reason explains a failure or describes the progress of a running
+	// action.
+	Reason string
+	// Progress is the percentage of the action which is done.
+	Progress uint32
+}
+
+// UpdateActionStatus reports the status of an action instance. It doesn't
+// check transitions, an ActionReporter does.
+func (a *AdminClient) UpdateActionStatus(ctx context.Context, instanceId string, update *ActionStatusUpdate) error {
+	ctx, cancel := withDefaultDeadline(ctx, a.opts.timeout)
+	defer cancel()
+	outcome := update.Outcome
+	if outcome == ActionOutcomeUnknown {
+		outcome = update.Status.Outcome()
+	}
+	now := uint64(time.Now().Unix())
+	req := &proto.UpdateActionStatusRequest{
+		ActionInstanceId: instanceId,
+		Status:           update.Status.ToProto(),
+		Outcome:          outcome.ToProto(),
+		OutcomeReason:    update.Reason,
+		UpdateTime:       now,
+		UdpateTime:       now,
+		Progress:         update.Progress,
+	}
+	_, err := a.client.UpdateActionStatus(ctx, req)
+	return wrapError("update action status", err)
+}
+
+func (a *AdminClient) ClusterMembers(ctx context.Context) ([]*ClusterMember, error) {
+	ctx, cancel := withDefaultDeadline(ctx, a.opts.timeout)
+	defer cancel()
+	resp, err := a.client.GetClusterMembers(ctx, &proto.GetClusterMembersRequest{})
+	if err != nil {
+		return nil, wrapError("get cluster members", err)
+	}
+	members := make([]*ClusterMember, 0, len(resp.Members))
+	for _, m := range resp.Members {
+		members = append(members, &ClusterMember{
+			Id:       m.Id,
+			HostName: m.HostName,
+			RPCAddr:  m.RpcAddr,
+			HTTPAddr: m.HttpAddr,
+		})
+	}
+	return members, nil
+}
@@ -0,0 +1,348 @@
+// Package agent runs ModelBox actions on a node. An agent registers the node
+// with the server, heartbeats while it runs, polls for the instances of the
+// actions it serves and executes them with an ActionHandler, reporting their
+// status as they run.
+package agent
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+
+	client "github.com/tensorland/modelbox/sdk-go"
+	"go.uber.org/zap"
+)
+
+const (
+	DEFAULT_HEARTBEAT_INTERVAL = 5 * time.Second
+	DEFAULT_POLL_INTERVAL      = 5 * time.Second
+	DEFAULT_CONCURRENCY        = 1
+	DEFAULT_DRAIN_TIMEOUT      = 5 * time.Minute
+)
+
+// ActionHandler executes the instances of actions polled by an agent.
+type ActionHandler interface {
+	// Handle runs an action instance until it is done. The instance is
+	// reported as succeeded when nil is returned and as failed with the
+	// error as reason otherwise. ctx is canceled when the agent can't wait
+	// for the action to finish anymore, the instance is then reported as
+	// cancelled.
+	Handle(ctx context.Context, action *client.RunnableAction, progress Progress) error
+}
+
+// ActionHandlerFunc adapts a function to an ActionHandler.
+type ActionHandlerFunc func(ctx context.Context, action *client.RunnableAction, progress Progress) error
+
+func (f ActionHandlerFunc) Handle(ctx context.Context, action *client.RunnableAction, progress Progress) error {
+	return f(ctx, action, progress)
+}
+
+// Progress reports the progress of a running action instance to the server,
+// it is implemented by client.ActionReporter.
+type Progress interface {
+	// Report reports a progress message.
+	Report(ctx context.Context, message string) error
+	// ReportPercent reports the percentage of the instance which is done.
+	ReportPercent(ctx context.Context, percent uint32, message string) error
+}
+
+type options struct {
+	heartbeatInterval time.Duration
+	pollInterval      time.Duration
+	concurrency       int
+	drainTimeout      time.Duration
+	arch              string
+	hostName          string
+	ipAddr            string
+	logger            *zap.Logger
+}
+
+type Option func(*options)
+
+// WithHeartbeatInterval sets how often the agent heartbeats with the server,
+// registration is retried at the same interval.
+func WithHeartbeatInterval(d time.Duration) Option {
+	return func(o *options) {
+		o.heartbeatInterval = d
+	}
+}
+
+// WithPollInterval sets how often the agent polls for runnable actions.
+func WithPollInterval(d time.Duration) Option {
+	return func(o *options) {
+		o.pollInterval = d
+	}
+}
+
+// WithConcurrency sets how many action instances run at the same time.
+func WithConcurrency(n int) Option {
+	return func(o *options) {
+		o.concurrency = n
+	}
+}
+
+// WithDrainTimeout sets how long a stopping agent waits for running actions
+// before canceling them.
+func WithDrainTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.drainTimeout = d
+	}
+}
+
+// WithArch sets the architecture the agent polls actions for, it defaults
+// to the architecture of the node.
+func WithArch(arch string) Option {
+	return func(o *options) {
+		o.arch = arch
+	}
+}
+
+// WithNodeAddr sets the host name and address the node is registered with.
+func WithNodeAddr(hostName, ipAddr string) Option {
+	return func(o *options) {
+		o.hostName = hostName
+		o.ipAddr = ipAddr
+	}
+}
+
+func WithLogger(logger *zap.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// Agent polls for the instances of a set of actions and runs them.
+type Agent struct {
+	admin   *client.AdminClient
+	name    string
+	actions []string
+	handler ActionHandler
+	opts    options
+	logger  *zap.Logger
+
+	mu      sync.Mutex
+	nodeId  string
+	running map[string]bool
+	wg      sync.WaitGroup
+}
+
+// New creates an agent named name which runs the instances of actions with
+// handler.
+func New(admin *client.AdminClient, name string, actions []string, handler ActionHandler, opts ...Option) (*Agent, error) {
+	if len(actions) == 0 {
+		return nil, fmt.Errorf("agent %v doesn't serve any action", name)
+	}
+	o := options{
+		heartbeatInterval: DEFAULT_HEARTBEAT_INTERVAL,
+		pollInterval:      DEFAULT_POLL_INTERVAL,
+		concurrency:       DEFAULT_CONCURRENCY,
+		drainTimeout:      DEFAULT_DRAIN_TIMEOUT,
+		arch:              nodeArch(),
+		logger:            zap.NewNop(),
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.concurrency < 1 {
+		return nil, fmt.Errorf("invalid concurrency %v", o.concurrency)
+	}
+	if o.hostName == "" {
+		o.hostName, _ = os.Hostname()
+	}
+	if o.ipAddr == "" {
+		o.ipAddr = defaultAddr()
+	}
+	return &Agent{
+		admin:   admin,
+		name:    name,
+		actions: actions,
+		handler: handler,
+		opts:    o,
+		logger:  o.logger.With(zap.String("agent", name)),
+		running: map[string]bool{},
+	}, nil
+}
+
+// NodeId returns the id the server assigned to the node once registered.
+func (a *Agent) NodeId() string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.nodeId
+}
+
+// Run registers the agent and runs actions until ctx is canceled. The agent
+// then stops polling and drains, it keeps heartbeating while the running
+// actions finish and cancels the ones still running after the drain timeout.
+func (a *Agent) Run(ctx context.Context) error {
+	if err := a.register(ctx); err != nil {
+		return err
+	}
+	// actions and heartbeats outlive ctx while the agent drains
+	actionCtx, cancelActions := context.WithCancel(context.Background())
+	defer cancelActions()
+	heartbeatCtx, stopHeartbeat := context.WithCancel(context.Background())
+	heartbeatDone := make(chan struct{})
+	go func() {
+		defer close(heartbeatDone)
+		a.heartbeat(heartbeatCtx)
+	}()
+
+	a.poll(ctx, actionCtx)
+	a.drain(cancelActions)
+
+	stopHeartbeat()
+	<-heartbeatDone
+	return nil
+}
+
+func (a *Agent) register(ctx context.Context) error {
+	node := &client.NodeInfo{HostName: a.opts.hostName, IPAddr: a.opts.ipAddr, Arch: a.opts.arch}
+	for {
+		nodeId, err := a.admin.RegisterAgent(ctx, a.name, node)
+		if err == nil {
+			a.mu.Lock()
+			a.nodeId = nodeId
+			a.mu.Unlock()
+			a.logger.Info("registered node", zap.String("node_id", nodeId))
+			return nil
+		}
+		a.logger.Error("unable to register agent", zap.Error(err))
+		select {
+		case <-time.After(a.opts.heartbeatInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (a *Agent) heartbeat(ctx context.Context) {
+	ticker := time.NewTicker(a.opts.heartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+		if err := a.admin.Heartbeat(ctx, a.NodeId()); err != nil && ctx.Err() == nil {
+			a.logger.Error("couldn't heartbeat", zap.Error(err))
+		}
+	}
+}
+
+func (a *Agent) poll(ctx, actionCtx context.Context) {
+	ticker := time.NewTicker(a.opts.pollInterval)
+	defer ticker.Stop()
+	for {
+		for _, name := range a.actions {
+			a.pollAction(ctx, actionCtx, name)
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (a *Agent) pollAction(ctx, actionCtx context.Context, name string) {
+	actions, err := a.admin.GetRunnableActions(ctx, name, a.opts.arch)
+	if err != nil {
+		if ctx.Err() == nil {
+			a.logger.Error("unable to get runnable actions", zap.String("action", name), zap.Error(err))
+		}
+		return
+	}
+	for _, action := range actions {
+		a.mu.Lock()
+		// instances are returned until they are reported as running
+		start := !a.running[action.Id] && len(a.running) < a.opts.concurrency
+		if start {
+			a.running[action.Id] = true
+			a.wg.Add(1)
+		}
+		a.mu.Unlock()
+		if start {
+			go a.runAction(actionCtx, action)
+		}
+	}
+}
+
+func (a *Agent) runAction(ctx context.Context, action *client.RunnableAction) {
+	defer func() {
+		a.mu.Lock()
+		delete(a.running, action.Id)
+		a.mu.Unlock()
+		a.wg.Done()
+	}()
+	logger := a.logger.With(zap.String("action_instance", action.Id))
+	// statuses are reported even once the action is canceled
+	reportCtx := context.Background()
+	reporter := a.admin.NewActionReporter(action.Id, client.ActionStatusPending)
+	if err := reporter.Start(reportCtx); err != nil {
+		// the instance is polled again until it is reported as running
+		logger.Error("unable to report action as running", zap.Error(err))
+		return
+	}
+	logger.Info("running action", zap.String("command", action.Command))
+	err := a.handler.Handle(ctx, action, reporter)
+	switch {
+	case err == nil:
+		err = reporter.Succeed(reportCtx)
+	case ctx.Err() != nil:
+		logger.Warn("action cancelled", zap.Error(err))
+		err = reporter.Cancel(reportCtx, "agent stopped before the action finished")
+	default:
+		logger.Error("action failed", zap.Error(err))
+		err = reporter.Fail(reportCtx, err)
+	}
+	if err != nil {
+		logger.Error("unable to report action outcome", zap.Error(err))
+	}
+}
+
+// drain waits for the running actions and cancels them after the drain
+// timeout.
+func (a *Agent) drain(cancelActions context.CancelFunc) {
+	done := make(chan struct{})
+	go func() {
+		a.wg.Wait()
+		close(done)
+	}()
+	a.logger.Info("draining running actions")
+	select {
+	case <-done:
+		return
+	case <-time.After(a.opts.drainTimeout):
+	}
+	a.logger.Warn("canceling running actions after drain timeout")
+	cancelActions()
+	<-done
+}
+
+// nodeArch returns the architecture of the node with the names used by the
+// other agents, e.g. x86_64 rather than amd64.
+func nodeArch() string {
+	switch runtime.GOARCH {
+	case "amd64":
+		return "x86_64"
+	case "arm64":
+		return "aarch64"
+	}
+	return runtime.GOARCH
+}
+
+// defaultAddr returns the address of the interface used to reach the
+// internet. Dialing UDP doesn't send any packet.
+func defaultAddr() string {
+	conn, err := net.Dial("udp", "8.8.8.8:80")
+	if err != nil {
+		return ""
+	}
+	defer conn.Close()
+	return conn.LocalAddr().(*net.UDPAddr).IP.String()
+}
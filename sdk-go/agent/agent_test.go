@@ -0,0 +1,192 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	client "github.com/tensorland/modelbox/sdk-go"
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type statusUpdate struct {
+	id      string
+	status  client.ActionStatus
+	outcome client.ActionOutcome
+	reason  string
+}
+
+// adminServer hands out its actions until they are reported as running.
+type adminServer struct {
+	proto.UnimplementedModelBoxAdminServer
+
+	mu         sync.Mutex
+	actions    []*proto.RunnableAction
+	heartbeats int
+	updates    []statusUpdate
+}
+
+func (s *adminServer) RegisterAgent(ctx context.Context, req *proto.RegisterAgentRequest) (*proto.RegisterAgentResponse, error) {
+	return &proto.RegisterAgentResponse{NodeId: "node-1"}, nil
+}
+
+func (s *adminServer) Heartbeat(ctx context.Context, req *proto.HeartbeatRequest) (*proto.HeartbeatResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.heartbeats++
+	return &proto.HeartbeatResponse{}, nil
+}
+
+func (s *adminServer) GetRunnableActionInstances(ctx context.Context, req *proto.GetRunnableActionInstancesRequest) (*proto.GetRunnableActionInstancesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &proto.GetRunnableActionInstancesResponse{Instances: s.actions}, nil
+}
+
+func (s *adminServer) UpdateActionStatus(ctx context.Context, req *proto.UpdateActionStatusRequest) (*proto.UpdateActionStatusResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.updates = append(s.updates, statusUpdate{
+		id:      req.ActionInstanceId,
+		status:  client.ActionStatusFromProto(req.Status),
+		outcome: client.ActionOutcomeFromProto(req.Outcome),
+		reason:  req.OutcomeReason,
+	})
+	remaining := s.actions[:0]
+	for _, action := range s.actions {
+		if action.Id != req.ActionInstanceId {
+			remaining = append(remaining, action)
+		}
+	}
+	s.actions = remaining
+	return &proto.UpdateActionStatusResponse{}, nil
+}
+
+func (s *adminServer) statusUpdates() []statusUpdate {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]statusUpdate(nil), s.updates...)
+}
+
+func newTestAdminClient(t *testing.T, server *adminServer) *client.AdminClient {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	proto.RegisterModelBoxAdminServer(s, server)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+	dialer := func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}
+	admin, err := client.NewAdminClient("bufnet", client.WithDialOptions(grpc.WithContextDialer(dialer)))
+	assert.Nil(t, err)
+	t.Cleanup(func() { admin.Close() })
+	return admin
+}
+
+func TestAgentRunsActions(t *testing.T) {
+	server := &adminServer{actions: []*proto.RunnableAction{
+		{Id: "instance-1", ActionId: "action-1", Command: "ok"},
+		{Id: "instance-2", ActionId: "action-1", Command: "fail"},
+	}}
+	admin := newTestAdminClient(t, server)
+	handled := make(chan string, 2)
+	handler := ActionHandlerFunc(func(ctx context.Context, action *client.RunnableAction, progress Progress) error {
+		defer func() { handled <- action.Id }()
+		if err := progress.Report(ctx, "halfway"); err != nil {
+			return err
+		}
+		if action.Command == "fail" {
+			return errors.New("exit status 1")
+		}
+		return nil
+	})
+	a, err := New(admin, "test-agent", []string{"quantize"}, handler,
+		WithPollInterval(10*time.Millisecond), WithHeartbeatInterval(10*time.Millisecond), WithConcurrency(2))
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- a.Run(ctx) }()
+	<-handled
+	<-handled
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	assert.Nil(t, <-done)
+
+	assert.Equal(t, "node-1", a.NodeId())
+	updates := map[string][]statusUpdate{}
+	for _, u := range server.statusUpdates() {
+		updates[u.id] = append(updates[u.id], u)
+	}
+	assert.Equal(t, []statusUpdate{
+		{"instance-1", client.ActionStatusRunning, client.ActionOutcomeUnknown, ""},
+		{"instance-1", client.ActionStatusRunning, client.ActionOutcomeUnknown, "halfway"},
+		{"instance-1", client.ActionStatusSucceeded, client.ActionOutcomeSuccess, ""},
+	}, updates["instance-1"])
+	assert.Equal(t, []statusUpdate{
+		{"instance-2", client.ActionStatusRunning, client.ActionOutcomeUnknown, ""},
+		{"instance-2", client.ActionStatusRunning, client.ActionOutcomeUnknown, "halfway"},
+		{"instance-2", client.ActionStatusFailed, client.ActionOutcomeFailure, "exit status 1"},
+	}, updates["instance-2"])
+	server.mu.Lock()
+	assert.Greater(t, server.heartbeats, 0)
+	server.mu.Unlock()
+}
+
+func TestAgentDrainsRunningActions(t *testing.T) {
+	for name, test := range map[string]struct {
+		drainTimeout time.Duration
+		status       client.ActionStatus
+	}{
+		"finishes":  {time.Minute, client.ActionStatusSucceeded},
+		"times out": {10 * time.Millisecond, client.ActionStatusCancelled},
+	} {
+		t.Run(name, func(t *testing.T) {
+			server := &adminServer{actions: []*proto.RunnableAction{{Id: "instance-1"}}}
+			admin := newTestAdminClient(t, server)
+			started := make(chan struct{})
+			release := make(chan struct{})
+			handler := ActionHandlerFunc(func(ctx context.Context, action *client.RunnableAction, progress Progress) error {
+				close(started)
+				select {
+				case <-release:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			})
+			a, err := New(admin, "test-agent", []string{"quantize"}, handler,
+				WithPollInterval(10*time.Millisecond), WithDrainTimeout(test.drainTimeout))
+			assert.Nil(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan error)
+			go func() { done <- a.Run(ctx) }()
+			<-started
+			cancel()
+			select {
+			case <-done:
+				if test.status == client.ActionStatusSucceeded {
+					t.Fatal("agent stopped before the running action finished")
+				}
+			case <-time.After(50 * time.Millisecond):
+				close(release)
+				<-done
+			}
+			updates := server.statusUpdates()
+			last := updates[len(updates)-1]
+			assert.Equal(t, test.status, last.status)
+		})
+	}
+}
+
+func TestNewWithoutActions(t *testing.T) {
+	_, err := New(nil, "test-agent", nil, nil)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,324 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
+
+	client "github.com/tensorland/modelbox/sdk-go"
+)
+
+const (
+	// Environment variables set for the commands run by an Executor.
+	ENV_ACTION_ID          = "MODELBOX_ACTION_ID"
+	ENV_ACTION_INSTANCE_ID = "MODELBOX_ACTION_INSTANCE_ID"
+	ENV_OBJECT_ID          = "MODELBOX_OBJECT_ID"
+	ENV_PARAMS_FILE        = "MODELBOX_PARAMS_FILE"
+	// Every param is also set as MODELBOX_PARAM_<NAME>, with the name in
+	// upper case.
+	ENV_PARAM_PREFIX = "MODELBOX_PARAM_"
+
+	DEFAULT_LOG_ARTIFACT = "action-logs"
+)
+
+// limits are the resources a command may use, zero values are unlimited.
+type limits struct {
+	memory  uint64
+	cpuTime time.Duration
+}
+
+type executorOptions struct {
+	timeout     time.Duration
+	limits      limits
+	workDir     string
+	env         []string
+	logArtifact string
+}
+
+type ExecutorOption func(*executorOptions)
+
+// WithTimeout kills commands which run longer than d.
+func WithTimeout(d time.Duration) ExecutorOption {
+	return func(o *executorOptions) {
+		o.timeout = d
+	}
+}
+
+// WithMemoryLimit limits the address space of commands to bytes.
+func WithMemoryLimit(bytes uint64) ExecutorOption {
+	return func(o *executorOptions) {
+		o.limits.memory = bytes
+	}
+}
+
+// WithCPULimit limits the CPU time commands may use.
+func WithCPULimit(d time.Duration) ExecutorOption {
+	return func(o *executorOptions) {
+		o.limits.cpuTime = d
+	}
+}
+
+// WithWorkDir sets the directory the working directories of commands are
+// created in, it defaults to the temporary directory.
+func WithWorkDir(dir string) ExecutorOption {
+	return func(o *executorOptions) {
+		o.workDir = dir
+	}
+}
+
+// WithEnv adds environment variables, formatted as key=value, to the
+// environment of commands.
+func WithEnv(env ...string) ExecutorOption {
+	return func(o *executorOptions) {
+		o.env = append(o.env, env...)
+	}
+}
+
+// WithLogArtifact sets the name of the artifact logs are uploaded as.
+func WithLogArtifact(name string) ExecutorOption {
+	return func(o *executorOptions) {
+		o.logArtifact = name
+	}
+}
+
+// Executor is an ActionHandler which runs the command of actions as a
+// subprocess. Commands run in an empty working directory with a minimal
+// environment and their params, are killed along with their children when
+// they time out, and are subject to the memory and CPU limits on platforms
+// supporting rlimits; commands fail when the limits can't be enforced. Their
+// stdout and stderr are uploaded as an artifact of the object the action
+// runs on once they exit.
+//
+// Commands are split into arguments like a POSIX shell does, honouring
+// single and double quotes and backslashes, but aren't run by a shell:
+// variables, globs, pipes and redirections aren't interpreted. Commands
+// needing them can run a shell themselves, as in sh -c 'train | tee log'.
+type Executor struct {
+	client *client.ModelBoxClient
+	opts   executorOptions
+}
+
+// NewExecutor creates an executor uploading logs with mb. Logs aren't
+// uploaded when mb is nil.
+func NewExecutor(mb *client.ModelBoxClient, opts ...ExecutorOption) *Executor {
+	o := executorOptions{logArtifact: DEFAULT_LOG_ARTIFACT}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &Executor{client: mb, opts: o}
+}
+
+func (e *Executor) Handle(ctx context.Context, action *client.RunnableAction, progress Progress) error {
+	args, err := splitCommand(action.Command)
+	if err != nil {
+		return fmt.Errorf("invalid command of action instance %v: %v", action.Id, err)
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("action instance %v has no command", action.Id)
+	}
+	if args, err = limitCommand(args, e.opts.limits); err != nil {
+		return err
+	}
+	dir, err := os.MkdirTemp(e.opts.workDir, "modelbox-action-")
+	if err != nil {
+		return fmt.Errorf("unable to create working directory: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	env, err := e.environ(action, dir)
+	if err != nil {
+		return err
+	}
+	// logs are kept outside of the working directory which the command
+	// could clean up
+	logDir, err := os.MkdirTemp(e.opts.workDir, "modelbox-logs-")
+	if err != nil {
+		return fmt.Errorf("unable to create log directory: %v", err)
+	}
+	defer os.RemoveAll(logDir)
+	stdout, err := os.Create(filepath.Join(logDir, "stdout.log"))
+	if err != nil {
+		return err
+	}
+	defer stdout.Close()
+	stderr, err := os.Create(filepath.Join(logDir, "stderr.log"))
+	if err != nil {
+		return err
+	}
+	defer stderr.Close()
+
+	runCtx := ctx
+	if e.opts.timeout > 0 {
+		var cancel context.CancelFunc
+		runCtx, cancel = context.WithTimeout(ctx, e.opts.timeout)
+		defer cancel()
+	}
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Dir = dir
+	cmd.Env = env
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	runErr := runSandboxed(runCtx, cmd)
+	if errors.Is(runCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+		runErr = fmt.Errorf("command timed out after %v", e.opts.timeout)
+	}
+
+	if e.client != nil && action.ObjectId != "" {
+		if err := progress.Report(ctx, "uploading logs"); err != nil {
+			return err
+		}
+		for _, f := range []*os.File{stdout, stderr} {
+			if err := e.uploadLog(ctx, action, f); err != nil && runErr == nil {
+				runErr = fmt.Errorf("unable to upload logs: %v", err)
+			}
+		}
+	}
+	return runErr
+}
+
+// splitCommand splits a command into arguments at unquoted white space.
+// Single quotes preserve everything up to the closing quote, and backslashes
+// escape the next character outside of quotes and ", \, $ and ` within
+// double quotes.
+func splitCommand(command string) ([]string, error) {
+	var (
+		args    []string
+		arg     strings.Builder
+		inArg   bool
+		quote   rune
+		escaped bool
+	)
+	for _, c := range command {
+		switch {
+		case escaped:
+			if quote == '"' && !strings.ContainsRune("\"\\$`", c) {
+				arg.WriteRune('\\')
+			}
+			arg.WriteRune(c)
+			escaped = false
+		case quote == '\'':
+			if c == '\'' {
+				quote = 0
+			} else {
+				arg.WriteRune(c)
+			}
+		case c == '\\':
+			escaped, inArg = true, true
+		case quote == '"':
+			if c == '"' {
+				quote = 0
+			} else {
+				arg.WriteRune(c)
+			}
+		case c == '\'' || c == '"':
+			quote, inArg = c, true
+		case unicode.IsSpace(c):
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
+			}
+		default:
+			arg.WriteRune(c)
+			inArg = true
+		}
+	}
+	if escaped {
+		return nil, errors.New("trailing backslash")
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote", quote)
+	}
+	if inArg {
+		args = append(args, arg.String())
+	}
+	return args, nil
+}
+
+// environ returns the environment of the command of action. Only PATH is
+// inherited from the agent.
+func (e *Executor) environ(action *client.RunnableAction, dir string) ([]string, error) {
+	params, err := json.Marshal(action.Params)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode params: %v", err)
+	}
+	paramsPath := filepath.Join(dir, "params.json")
+	if err := os.WriteFile(paramsPath, params, 0600); err != nil {
+		return nil, err
+	}
+	env := []string{
+		"PATH=" + os.Getenv("PATH"),
+		"HOME=" + dir,
+		"TMPDIR=" + dir,
+		ENV_ACTION_ID + "=" + action.ActionId,
+		ENV_ACTION_INSTANCE_ID + "=" + action.Id,
+		ENV_OBJECT_ID + "=" + action.ObjectId,
+		ENV_PARAMS_FILE + "=" + paramsPath,
+	}
+	for name, value := range action.Params {
+		// strings are passed as is, other values as JSON
+		s, ok := value.(string)
+		if !ok {
+			b, err := json.Marshal(value)
+			if err != nil {
+				return nil, fmt.Errorf("unable to encode param %v: %v", name, err)
+			}
+			s = string(b)
+		}
+		env = append(env, ENV_PARAM_PREFIX+paramEnvName(name)+"="+s)
+	}
+	return append(env, e.opts.env...), nil
+}
+
+// paramEnvName upper cases name and replaces the characters which can't be
+// used in environment variable names.
+func paramEnvName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return unicode.ToUpper(r)
+		}
+		return '_'
+	}, name)
+}
+
+func (e *Executor) uploadLog(ctx context.Context, action *client.RunnableAction, f *os.File) error {
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	_, err = e.client.UploadReader(ctx, f, &client.UploadMetadata{
+		ArtifactName: e.opts.logArtifact,
+		ObjectId:     action.ObjectId,
+		FileType:     client.FileTypeText,
+		SrcPath:      fmt.Sprintf("%v/%v", action.Id, filepath.Base(f.Name())),
+		Size:         uint64(info.Size()),
+	})
+	return err
+}
+
+// runSandboxed runs cmd and kills it along with its children when ctx is
+// done.
+func runSandboxed(ctx context.Context, cmd *exec.Cmd) error {
+	setProcessGroup(cmd)
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- cmd.Wait()
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		killProcessGroup(cmd)
+		<-done
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,175 @@
+//go:build unix
+
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	client "github.com/tensorland/modelbox/sdk-go"
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// logServer keeps the files uploaded to it by source path.
+type logServer struct {
+	proto.UnimplementedModelStoreServer
+
+	mu        sync.Mutex
+	artifacts map[string]string
+	files     map[string][]byte
+}
+
+func (s *logServer) UploadFile(stream proto.ModelStore_UploadFileServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	meta := req.GetMetadata()
+	var data []byte
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&proto.UploadFileResponse{UploadId: meta.UploadId, CommittedOffset: uint64(len(data))})
+		}
+		if err != nil {
+			return err
+		}
+		if chunk := req.GetChunk(); chunk != nil {
+			data = append(data, chunk.Data...)
+		}
+		if req.GetCommit() != nil {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.artifacts[meta.Metadata.SrcPath] = meta.ArtifactName + "/" + meta.ObjectId
+			s.files[meta.Metadata.SrcPath] = data
+			return stream.SendAndClose(&proto.UploadFileResponse{FileId: "file-1", UploadId: meta.UploadId, Completed: true})
+		}
+	}
+}
+
+func newTestModelBoxClient(t *testing.T, server *logServer) *client.ModelBoxClient {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	proto.RegisterModelStoreServer(s, server)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+	dialer := func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}
+	mb, err := client.NewModelBoxClient("bufnet", client.WithDialOptions(grpc.WithContextDialer(dialer)))
+	assert.Nil(t, err)
+	t.Cleanup(func() { mb.Close() })
+	return mb
+}
+
+type progressLog struct {
+	messages []string
+}
+
+func (p *progressLog) Report(ctx context.Context, message string) error {
+	p.messages = append(p.messages, message)
+	return nil
+}
+
+func (p *progressLog) ReportPercent(ctx context.Context, percent uint32, message string) error {
+	return p.Report(ctx, message)
+}
+
+func writeScript(t *testing.T, script string) string {
+	path := filepath.Join(t.TempDir(), "action.sh")
+	assert.Nil(t, os.WriteFile(path, []byte(script), 0755))
+	return path
+}
+
+func TestExecutorRunsCommand(t *testing.T) {
+	server := &logServer{artifacts: map[string]string{}, files: map[string][]byte{}}
+	executor := NewExecutor(newTestModelBoxClient(t, server), WithEnv("EXTRA=1"))
+	script := writeScript(t, `echo "lr=$MODELBOX_PARAM_LEARNING_RATE epochs=$MODELBOX_PARAM_EPOCHS extra=$EXTRA"
+cat "$MODELBOX_PARAMS_FILE"
+echo "running $MODELBOX_ACTION_INSTANCE_ID on $MODELBOX_OBJECT_ID" >&2
+`)
+	action := &client.RunnableAction{
+		Id:       "instance-1",
+		ActionId: "action-1",
+		Command:  "sh " + script,
+		Params:   map[string]interface{}{"learning-rate": "0.1", "epochs": 3.0},
+		ObjectId: "mv-1",
+	}
+	progress := &progressLog{}
+	assert.Nil(t, executor.Handle(context.Background(), action, progress))
+
+	assert.Equal(t, []string{"uploading logs"}, progress.messages)
+	stdout := strings.SplitN(string(server.files["instance-1/stdout.log"]), "\n", 2)
+	assert.Equal(t, "lr=0.1 epochs=3 extra=1", stdout[0])
+	var params map[string]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(stdout[1]), &params))
+	assert.Equal(t, action.Params, params)
+	assert.Equal(t, "running instance-1 on mv-1\n", string(server.files["instance-1/stderr.log"]))
+	assert.Equal(t, DEFAULT_LOG_ARTIFACT+"/mv-1", server.artifacts["instance-1/stderr.log"])
+}
+
+func TestExecutorFailures(t *testing.T) {
+	for name, test := range map[string]struct {
+		script string
+		opts   []ExecutorOption
+		err    string
+	}{
+		"exit status": {"exit 3", nil, "exit status 3"},
+		"timeout":     {"sleep 10 & wait", []ExecutorOption{WithTimeout(100 * time.Millisecond)}, "timed out"},
+		"cpu limit":   {"while :; do :; done", []ExecutorOption{WithCPULimit(time.Second)}, "signal"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			executor := NewExecutor(nil, test.opts...)
+			action := &client.RunnableAction{Id: "instance-1", Command: "sh " + writeScript(t, test.script)}
+			start := time.Now()
+			err := executor.Handle(context.Background(), action, &progressLog{})
+			assert.ErrorContains(t, err, test.err)
+			assert.Less(t, time.Since(start), 5*time.Second)
+		})
+	}
+}
+
+func TestLimitCommand(t *testing.T) {
+	args, err := limitCommand([]string{"train"}, limits{})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"train"}, args)
+	args, err = limitCommand([]string{"train", "--fast"}, limits{memory: 1025, cpuTime: 1500 * time.Millisecond})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"/bin/sh", "-c", `ulimit -v 2 && ulimit -t 2 && exec "$@"`, "sh", "train", "--fast"}, args)
+}
+
+func TestSplitCommand(t *testing.T) {
+	for command, expected := range map[string][]string{
+		`python -c "print(1)"`:    {"python", "-c", "print(1)"},
+		`  train   --lr 0.1 `:     {"train", "--lr", "0.1"},
+		`echo 'a "b" $HOME' ""`:   {"echo", `a "b" $HOME`, ""},
+		`echo "a \"b\" \n" a\ b`:  {"echo", `a "b" \n`, "a b"},
+		`sh -c 'train | tee log'`: {"sh", "-c", "train | tee log"},
+		`run --name=a' b'"c"`:     {"run", "--name=a bc"},
+		"":                        nil,
+	} {
+		args, err := splitCommand(command)
+		assert.Nil(t, err, command)
+		assert.Equal(t, expected, args, command)
+	}
+	for _, command := range []string{`echo "a`, `echo 'a`, `echo a\`} {
+		_, err := splitCommand(command)
+		assert.NotNil(t, err, command)
+	}
+}
+
+func TestParamEnvName(t *testing.T) {
+	assert.Equal(t, "LEARNING_RATE", paramEnvName("learning-rate"))
+	assert.Equal(t, "BATCH_SIZE2", paramEnvName("batch.size2"))
+}
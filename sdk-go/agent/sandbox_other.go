@@ -0,0 +1,23 @@
+//go:build !unix
+
+package agent
+
+import (
+	"errors"
+	"os/exec"
+)
+
+// limitCommand fails when limits are set, they aren't supported on this
+// platform.
+func limitCommand(args []string, l limits) ([]string, error) {
+	if l != (limits{}) {
+		return nil, errors.New("resource limits aren't supported on this platform")
+	}
+	return args, nil
+}
+
+func setProcessGroup(cmd *exec.Cmd) {}
+
+func killProcessGroup(cmd *exec.Cmd) {
+	cmd.Process.Kill()
+}
@@ -0,0 +1,41 @@
+//go:build unix
+
+package agent
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// limitCommand wraps args in a shell which sets the limits before replacing
+// itself with the command, as rlimits can't be set on children directly. It
+// fails when limits are set and there is no shell to enforce them.
+func limitCommand(args []string, l limits) ([]string, error) {
+	script := ""
+	if l.memory > 0 {
+		script += fmt.Sprintf("ulimit -v %d && ", (l.memory+1023)/1024)
+	}
+	if l.cpuTime > 0 {
+		seconds := (l.cpuTime.Milliseconds() + 999) / 1000
+		script += fmt.Sprintf("ulimit -t %d && ", seconds)
+	}
+	if script == "" {
+		return args, nil
+	}
+	if _, err := os.Stat("/bin/sh"); err != nil {
+		return nil, fmt.Errorf("unable to enforce resource limits: %v", err)
+	}
+	return append([]string{"/bin/sh", "-c", script + `exec "$@"`, "sh"}, args...), nil
+}
+
+// setProcessGroup starts cmd in its own process group so that its children
+// can be killed with it.
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+func killProcessGroup(cmd *exec.Cmd) {
+	syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+}
@@ -0,0 +1,348 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ChangeEventType is the kind of change reported by a Watcher.
+type ChangeEventType uint8
+
+const (
+	ChangeEventUndefined ChangeEventType = iota
+	ChangeEventObjectCreated
+	ChangeEventObjectUpdated
+)
+
+func changeEventTypeFromProto(e proto.ChangeEvent) ChangeEventType {
+	switch e {
+	case proto.ChangeEvent_OBJECT_CREATED:
+		return ChangeEventObjectCreated
+	case proto.ChangeEvent_OBJECT_UPDATED:
+		return ChangeEventObjectUpdated
+	}
+	return ChangeEventUndefined
+}
+
+// ToProto converts the type to its wire representation.
+func (e ChangeEventType) ToProto() proto.ChangeEvent {
+	switch e {
+	case ChangeEventObjectCreated:
+		return proto.ChangeEvent_OBJECT_CREATED
+	case ChangeEventObjectUpdated:
+		return proto.ChangeEvent_OBJECT_UPDATED
+	}
+	return proto.ChangeEvent_CHANGE_EVENT_UNDEFINED
+}
+
+func (e ChangeEventType) String() string {
+	switch e {
+	case ChangeEventObjectCreated:
+		return "created"
+	case ChangeEventObjectUpdated:
+		return "updated"
+	}
+	return "undefined"
+}
+
+// ChangeEventTypeFromStr parses the name of a change type, as returned by
+// String.
+func ChangeEventTypeFromStr(name string) (ChangeEventType, error) {
+	switch strings.ToLower(name) {
+	case "created":
+		return ChangeEventObjectCreated, nil
+	case "updated":
+		return ChangeEventObjectUpdated, nil
+	}
+	return ChangeEventUndefined, fmt.Errorf("unknown change event %v", name)
+}
+
+func (e ChangeEventType) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// ChangeEvent is a change to an object of a namespace.
+type ChangeEvent struct {
+	Type     ChangeEventType
+	Kind     ObjectKind
+	ObjectId string
+	// Position of the event in the change stream of the namespace, a watch
+	// started after this position receives the events which follow it.
+	Position uint64
+	// Object is the object after the change, an *Experiment, *Model,
+	// *ModelVersion, *Artifact or *MetadataChange depending on Kind. It is
+	// nil when the payload can't be decoded, DecodeErr then tells why and
+	// Payload holds the raw value.
+	Object    interface{}
+	DecodeErr error
+	Payload   *structpb.Value
+}
+
+// ObjectKind is the kind of object a ChangeEvent is about.
+type ObjectKind uint8
+
+const (
+	ObjectKindUndefined ObjectKind = iota
+	ObjectKindExperiment
+	ObjectKindModel
+	ObjectKindModelVersion
+	ObjectKindArtifact
+	ObjectKindMetadata
+)
+
+func objectKindFromProto(k proto.ObjectKind) ObjectKind {
+	switch k {
+	case proto.ObjectKind_OBJECT_KIND_EXPERIMENT:
+		return ObjectKindExperiment
+	case proto.ObjectKind_OBJECT_KIND_MODEL:
+		return ObjectKindModel
+	case proto.ObjectKind_OBJECT_KIND_MODEL_VERSION:
+		return ObjectKindModelVersion
+	case proto.ObjectKind_OBJECT_KIND_ARTIFACT:
+		return ObjectKindArtifact
+	case proto.ObjectKind_OBJECT_KIND_METADATA:
+		return ObjectKindMetadata
+	}
+	return ObjectKindUndefined
+}
+
+// ToProto converts the kind to its wire representation.
+func (k ObjectKind) ToProto() proto.ObjectKind {
+	switch k {
+	case ObjectKindExperiment:
+		return proto.ObjectKind_OBJECT_KIND_EXPERIMENT
+	case ObjectKindModel:
+		return proto.ObjectKind_OBJECT_KIND_MODEL
+	case ObjectKindModelVersion:
+		return proto.ObjectKind_OBJECT_KIND_MODEL_VERSION
+	case ObjectKindArtifact:
+		return proto.ObjectKind_OBJECT_KIND_ARTIFACT
+	case ObjectKindMetadata:
+		return proto.ObjectKind_OBJECT_KIND_METADATA
+	}
+	return proto.ObjectKind_OBJECT_KIND_UNDEFINED
+}
+
+func (k ObjectKind) String() string {
+	switch k {
+	case ObjectKindExperiment:
+		return "experiment"
+	case ObjectKindModel:
+		return "model"
+	case ObjectKindModelVersion:
+		return "model_version"
+	case ObjectKindArtifact:
+		return "artifact"
+	case ObjectKindMetadata:
+		return "metadata"
+	}
+	return "undefined"
+}
+
+// ObjectKindFromStr parses the name of an object kind, as returned by
+// String.
+func ObjectKindFromStr(name string) (ObjectKind, error) {
+	switch strings.ToLower(name) {
+	case "experiment":
+		return ObjectKindExperiment, nil
+	case "model":
+		return ObjectKindModel, nil
+	case "model_version":
+		return ObjectKindModelVersion, nil
+	case "artifact":
+		return ObjectKindArtifact, nil
+	case "metadata":
+		return ObjectKindMetadata, nil
+	}
+	return ObjectKindUndefined, fmt.Errorf("unknown object kind %v", name)
+}
+
+func (k ObjectKind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// MetadataChange is the metadata of an object after a change.
+type MetadataChange struct {
+	ObjectId string
+	Metadata map[string]interface{}
+}
+
+// The payloads of change events are the objects as stored by the server, the
+// structs below mirror their JSON encoding.
+
+type experimentPayload struct {
+	Id          string      `json:"id"`
+	Name        string      `json:"name"`
+	ExternalId  string      `json:"external_id"`
+	Owner       string      `json:"owner"`
+	Namespace   string      `json:"namespace"`
+	MLFramework int32       `json:"ml_framework"`
+	CreatedAt   payloadTime `json:"created_at"`
+	UpdatedAt   payloadTime `json:"updated_at"`
+}
+
+type modelPayload struct {
+	Id          string      `json:"id"`
+	Name        string      `json:"name"`
+	Owner       string      `json:"owner"`
+	Namespace   string      `json:"namespace"`
+	Task        string      `json:"task"`
+	Description string      `json:"description"`
+	CreatedAt   payloadTime `json:"created_at"`
+	UpdatedAt   payloadTime `json:"updated_at"`
+}
+
+type modelVersionPayload struct {
+	Id          string      `json:"id"`
+	Name        string      `json:"name"`
+	ModelId     string      `json:"model_id"`
+	Version     string      `json:"version"`
+	Description string      `json:"description"`
+	MLFramework int32       `json:"ml_framework"`
+	UniqueTags  []string    `json:"unique_tags"`
+	CreatedAt   payloadTime `json:"created_at"`
+	UpdatedAt   payloadTime `json:"updated_at"`
+}
+
+type filePayload struct {
+	Id                string      `json:"id"`
+	ParentId          string      `json:"parent_id"`
+	FileType          int32       `json:"file_type"`
+	Checksum          string      `json:"checksum"`
+	ChecksumAlgorithm int32       `json:"checksum_algorithm"`
+	SrcPath           string      `json:"src_path"`
+	UploadPath        string      `json:"upload_path"`
+	Size              uint64      `json:"size"`
+	CreatedAt         payloadTime `json:"created_at"`
+	UpdatedAt         payloadTime `json:"updated_at"`
+}
+
+type artifactPayload struct {
+	Id       string         `json:"id"`
+	Name     string         `json:"name"`
+	ObjectId string         `json:"object_id"`
+	Files    []*filePayload `json:"files"`
+}
+
+type metadataPayload struct {
+	ObjectId string                 `json:"object_id"`
+	Metadata map[string]interface{} `json:"metadata"`
+}
+
+// payloadTime decodes the timestamps of payloads, which are either RFC 3339
+// or the date and time without a zone, in UTC.
+type payloadTime time.Time
+
+var payloadTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999",
+}
+
+func (t *payloadTime) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	for _, layout := range payloadTimeLayouts {
+		if parsed, err := time.Parse(layout, s); err == nil {
+			*t = payloadTime(parsed)
+			return nil
+		}
+	}
+	return fmt.Errorf("unable to parse time %q", s)
+}
+
+// decodeChangePayload decodes the payload of an event about an object of the
+// given kind into an *Experiment, *Model, *ModelVersion, *Artifact or
+// *MetadataChange.
+func decodeChangePayload(kind ObjectKind, payload *structpb.Value) (interface{}, error) {
+	if payload == nil {
+		return nil, fmt.Errorf("no payload for %v change", kind)
+	}
+	b, err := payload.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	switch kind {
+	case ObjectKindExperiment:
+		var p experimentPayload
+		if err := json.Unmarshal(b, &p); err != nil {
+			return nil, fmt.Errorf("unable to decode experiment: %v", err)
+		}
+		return &Experiment{
+			Id:         p.Id,
+			Name:       p.Name,
+			Namespace:  p.Namespace,
+			Owner:      p.Owner,
+			Framework:  MLFrameworkStrFromProto(proto.MLFramework(p.MLFramework)),
+			ExternalId: p.ExternalId,
+			CreatedAt:  time.Time(p.CreatedAt),
+			UpdatedAt:  time.Time(p.UpdatedAt),
+		}, nil
+	case ObjectKindModel:
+		var p modelPayload
+		if err := json.Unmarshal(b, &p); err != nil {
+			return nil, fmt.Errorf("unable to decode model: %v", err)
+		}
+		return &Model{
+			Id:          p.Id,
+			Name:        p.Name,
+			Owner:       p.Owner,
+			Namespace:   p.Namespace,
+			Description: p.Description,
+			Task:        p.Task,
+			CreatedAt:   time.Time(p.CreatedAt),
+			UpdatedAt:   time.Time(p.UpdatedAt),
+		}, nil
+	case ObjectKindModelVersion:
+		var p modelVersionPayload
+		if err := json.Unmarshal(b, &p); err != nil {
+			return nil, fmt.Errorf("unable to decode model version: %v", err)
+		}
+		return &ModelVersion{
+			Id:          p.Id,
+			ModelId:     p.ModelId,
+			Name:        p.Name,
+			Version:     p.Version,
+			Description: p.Description,
+			Framework:   MLFrameworkStrFromProto(proto.MLFramework(p.MLFramework)),
+			UniqueTags:  p.UniqueTags,
+			CreatedAt:   time.Time(p.CreatedAt),
+			UpdatedAt:   time.Time(p.UpdatedAt),
+		}, nil
+	case ObjectKindArtifact:
+		var p artifactPayload
+		if err := json.Unmarshal(b, &p); err != nil {
+			return nil, fmt.Errorf("unable to decode artifact: %v", err)
+		}
+		artifact := &Artifact{Id: p.Id, Name: p.Name, ObjectId: p.ObjectId}
+		for _, f := range p.Files {
+			artifact.Files = append(artifact.Files, &FileMetadata{
+				Id:                f.Id,
+				ParentId:          f.ParentId,
+				FileType:          FileTypeFromProto(proto.FileType(f.FileType)),
+				Checksum:          f.Checksum,
+				ChecksumAlgorithm: ChecksumAlgorithmFromProto(proto.ChecksumAlgorithm(f.ChecksumAlgorithm)),
+				SrcPath:           f.SrcPath,
+				UploadPath:        f.UploadPath,
+				Size:              f.Size,
+				CreatedAt:         time.Time(f.CreatedAt),
+				UpdatedAt:         time.Time(f.UpdatedAt),
+			})
+		}
+		return artifact, nil
+	case ObjectKindMetadata:
+		var p metadataPayload
+		if err := json.Unmarshal(b, &p); err != nil {
+			return nil, fmt.Errorf("unable to decode metadata: %v", err)
+		}
+		return &MetadataChange{ObjectId: p.ObjectId, Metadata: p.Metadata}, nil
+	}
+	return nil, fmt.Errorf("unknown object kind %v", kind)
+}
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestDecodeChangePayload(t *testing.T) {
+	created := time.Date(2022, 10, 25, 10, 12, 32, 0, time.UTC)
+	for name, test := range map[string]struct {
+		kind     ObjectKind
+		payload  map[string]interface{}
+		expected interface{}
+	}{
+		"experiment": {
+			ObjectKindExperiment,
+			map[string]interface{}{
+				"id": "exp-1", "name": "gpt", "external_id": "ext", "owner": "owner@tensorland.ai",
+				"namespace": "ns", "ml_framework": 1, "created_at": "2022-10-25 10:12:32.0",
+			},
+			&Experiment{Id: "exp-1", Name: "gpt", ExternalId: "ext", Owner: "owner@tensorland.ai",
+				Namespace: "ns", Framework: "pytorch", CreatedAt: created},
+		},
+		"model version": {
+			ObjectKindModelVersion,
+			map[string]interface{}{
+				"id": "mv-1", "model_id": "model-1", "version": "2", "ml_framework": 2,
+				"unique_tags": []interface{}{"prod"}, "created_at": "2022-10-25T10:12:32Z",
+			},
+			&ModelVersion{Id: "mv-1", ModelId: "model-1", Version: "2", Framework: "keras",
+				UniqueTags: []string{"prod"}, CreatedAt: created},
+		},
+		"artifact": {
+			ObjectKindArtifact,
+			map[string]interface{}{
+				"id": "artifact-1", "name": "weights", "object_id": "mv-1",
+				"files": []interface{}{map[string]interface{}{"id": "file-1", "checksum_algorithm": 1, "size": 10}},
+			},
+			&Artifact{Id: "artifact-1", Name: "weights", ObjectId: "mv-1",
+				Files: []*FileMetadata{{Id: "file-1", ChecksumAlgorithm: ChecksumSHA256, Size: 10}}},
+		},
+		"metadata": {
+			ObjectKindMetadata,
+			map[string]interface{}{"object_id": "exp-1", "metadata": map[string]interface{}{"lr": 0.1}},
+			&MetadataChange{ObjectId: "exp-1", Metadata: map[string]interface{}{"lr": 0.1}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			payload, err := structpb.NewValue(test.payload)
+			assert.Nil(t, err)
+			object, err := decodeChangePayload(test.kind, payload)
+			assert.Nil(t, err)
+			assert.Equal(t, test.expected, object)
+		})
+	}
+}
+
+func TestDecodeChangePayloadErrors(t *testing.T) {
+	_, err := decodeChangePayload(ObjectKindUndefined, structpb.NewStringValue("x"))
+	assert.NotNil(t, err)
+	_, err = decodeChangePayload(ObjectKindModel, structpb.NewStringValue("x"))
+	assert.NotNil(t, err)
+}
+
+func TestObjectKindFromStr(t *testing.T) {
+	for kind := ObjectKindExperiment; kind <= ObjectKindMetadata; kind++ {
+		parsed, err := ObjectKindFromStr(kind.String())
+		assert.Nil(t, err)
+		assert.Equal(t, kind, parsed)
+	}
+	_, err := ObjectKindFromStr("dataset")
+	assert.NotNil(t, err)
+}
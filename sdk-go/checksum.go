@@ -0,0 +1,127 @@
+package client
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"lukechampine.com/blake3"
+)
+
+// ChecksumAlgorithm is the digest used to verify the integrity of files.
+// SHA-256 is used unless configured otherwise, MD5 is only kept to verify
+// files recorded before the algorithm was tracked.
+type ChecksumAlgorithm uint8
+
+const (
+	ChecksumMD5 ChecksumAlgorithm = iota
+	ChecksumSHA256
+	ChecksumBLAKE3
+	ChecksumXXHash64
+)
+
+const DEFAULT_CHECKSUM_ALGORITHM = ChecksumSHA256
+
+// ChecksumAlgorithmFromStr parses the name of an algorithm, as returned by
+// String.
+func ChecksumAlgorithmFromStr(name string) (ChecksumAlgorithm, error) {
+	switch strings.ToLower(name) {
+	case "md5":
+		return ChecksumMD5, nil
+	case "sha256":
+		return ChecksumSHA256, nil
+	case "blake3":
+		return ChecksumBLAKE3, nil
+	case "xxhash64":
+		return ChecksumXXHash64, nil
+	}
+	return ChecksumMD5, fmt.Errorf("unknown checksum algorithm %v", name)
+}
+
+// ChecksumAlgorithmFromProto converts the wire representation of an
+// algorithm.
+func ChecksumAlgorithmFromProto(a proto.ChecksumAlgorithm) ChecksumAlgorithm {
+	switch a {
+	case proto.ChecksumAlgorithm_SHA256:
+		return ChecksumSHA256
+	case proto.ChecksumAlgorithm_BLAKE3:
+		return ChecksumBLAKE3
+	case proto.ChecksumAlgorithm_XXHASH64:
+		return ChecksumXXHash64
+	}
+	return ChecksumMD5
+}
+
+// ToProto converts the algorithm to its wire representation.
+func (a ChecksumAlgorithm) ToProto() proto.ChecksumAlgorithm {
+	switch a {
+	case ChecksumSHA256:
+		return proto.ChecksumAlgorithm_SHA256
+	case ChecksumBLAKE3:
+		return proto.ChecksumAlgorithm_BLAKE3
+	case ChecksumXXHash64:
+		return proto.ChecksumAlgorithm_XXHASH64
+	}
+	return proto.ChecksumAlgorithm_MD5
+}
+
+func (a ChecksumAlgorithm) String() string {
+	switch a {
+	case ChecksumSHA256:
+		return "sha256"
+	case ChecksumBLAKE3:
+		return "blake3"
+	case ChecksumXXHash64:
+		return "xxhash64"
+	}
+	return "md5"
+}
+
+func (a ChecksumAlgorithm) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+func (a *ChecksumAlgorithm) UnmarshalText(text []byte) error {
+	algorithm, err := ChecksumAlgorithmFromStr(string(text))
+	if err != nil {
+		return err
+	}
+	*a = algorithm
+	return nil
+}
+
+// New returns a hash computing the algorithm's digest.
+func (a ChecksumAlgorithm) New() hash.Hash {
+	switch a {
+	case ChecksumSHA256:
+		return sha256.New()
+	case ChecksumBLAKE3:
+		return blake3.New(32, nil)
+	case ChecksumXXHash64:
+		return xxhash.New()
+	}
+	return md5.New()
+}
+
+// FileChecksum computes the checksum of the file at path, for instance to
+// track files which are stored outside of ModelBox.
+func FileChecksum(path string, algorithm ChecksumAlgorithm) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("error opening file: %v", err)
+	}
+	defer f.Close()
+
+	h := algorithm.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("error reading file while calculating checksum: %v", err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,33 @@
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChecksumAlgorithmRoundTrip(t *testing.T) {
+	for _, a := range []ChecksumAlgorithm{ChecksumMD5, ChecksumSHA256, ChecksumBLAKE3, ChecksumXXHash64} {
+		parsed, err := ChecksumAlgorithmFromStr(a.String())
+		assert.Nil(t, err)
+		assert.Equal(t, a, parsed)
+		assert.Equal(t, a, ChecksumAlgorithmFromProto(a.ToProto()))
+	}
+	_, err := ChecksumAlgorithmFromStr("crc32")
+	assert.NotNil(t, err)
+}
+
+func TestFileChecksum(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "model.pt")
+	assert.Nil(t, os.WriteFile(path, []byte("modelbox"), 0644))
+	for algorithm, expected := range map[ChecksumAlgorithm]string{
+		ChecksumMD5:    "ccc2911aab9e882d2f31b222a6ba4f68",
+		ChecksumSHA256: "5bf0ee343625758b3cc126da2d90601284f28df4e932f57fb827259d6805d7c2",
+	} {
+		checksum, err := FileChecksum(path, algorithm)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, checksum)
+	}
+}
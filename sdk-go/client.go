@@ -2,19 +2,14 @@ package client
 
 import (
 	"context"
-	"crypto/md5"
 	"fmt"
-	"io"
-	"os"
 	"strings"
 	"time"
 
 	"github.com/tensorland/modelbox/sdk-go/proto"
-	"github.com/tensorland/modelbox/server"
-	"github.com/tensorland/modelbox/server/storage/artifacts"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func MLFrameworkProtoFromStr(framework string) proto.MLFramework {
@@ -28,21 +23,36 @@ func MLFrameworkProtoFromStr(framework string) proto.MLFramework {
 }
 
 const (
+	// DEADLINE bounds unary calls made with a context which has no deadline.
 	DEADLINE = 10 * time.Second
 )
 
+// withDefaultDeadline applies timeout to ctx unless the caller has already
+// set a deadline.
+func withDefaultDeadline(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
 type CheckpointDownloadResponse struct {
-	Checksum       string
-	ServerChecksum string
+	Checksum          string            `json:"checksum"`
+	ServerChecksum    string            `json:"server_checksum"`
+	ChecksumAlgorithm ChecksumAlgorithm `json:"checksum_algorithm"`
 }
 
 type FileUploadResponse struct {
-	Id       string
-	Checksum string
+	Id         string `json:"id"`
+	ArtifactId string `json:"artifact_id"`
+	Checksum   string `json:"checksum"`
 }
 
 type CreateModelApiResponse struct {
-	Id string
+	Id        string    `json:"id"`
+	Exists    bool      `json:"exists"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
 type ChangeStreamEventResponse struct {
@@ -53,277 +63,330 @@ type ChangeStreamEventResponse struct {
 type ModelBoxClient struct {
 	conn   *grpc.ClientConn
 	client proto.ModelStoreClient
+	opts   *clientOptions
 }
 
-func NewModelBoxClient(addr string) (*ModelBoxClient, error) {
-	var opts []grpc.DialOption
-	opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	conn, err := grpc.Dial(addr, opts...)
+func NewModelBoxClient(addr string, opts ...ClientOption) (*ModelBoxClient, error) {
+	conn, o, err := dial(addr, opts...)
 	if err != nil {
 		return nil, err
 	}
 	client := proto.NewModelStoreClient(conn)
-	return &ModelBoxClient{conn: conn, client: client}, nil
+	return &ModelBoxClient{conn: conn, client: client, opts: o}, nil
+}
+
+func (m *ModelBoxClient) Close() error {
+	return m.conn.Close()
 }
 
-func (m *ModelBoxClient) CreateExperiment(name, owner, namespace, framework string) (string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), DEADLINE)
+func (m *ModelBoxClient) CreateExperiment(ctx context.Context, name, owner, namespace, externalId, framework string) (*CreateExperimentResponse, error) {
+	ctx, cancel := withDefaultDeadline(ctx, m.opts.timeout)
 	defer cancel()
 	req := &proto.CreateExperimentRequest{
-		Name:      name,
-		Owner:     owner,
-		Namespace: namespace,
-		Framework: MLFrameworkProtoFromStr(framework),
+		Name:           name,
+		Owner:          m.opts.ownerOrDefault(owner),
+		Namespace:      m.opts.namespaceOrDefault(namespace),
+		ExternalId:     externalId,
+		Framework:      MLFrameworkProtoFromStr(framework),
+		IdempotencyKey: newIdempotencyKey(),
 	}
 	resp, err := m.client.CreateExperiment(ctx, req)
 	if err != nil {
-		return "", err
+		return nil, wrapError("create experiment", err)
 	}
-	return resp.ExperimentId, nil
+	return &CreateExperimentResponse{
+		Id:        resp.ExperimentId,
+		Exists:    resp.ExperimentExists,
+		CreatedAt: toTime(resp.CreatedAt),
+		UpdatedAt: toTime(resp.UpdatedAt),
+	}, nil
 }
 
-func (m *ModelBoxClient) ListExperiments(namespace string) (*proto.ListExperimentsResponse, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), DEADLINE)
-	defer cancel()
-	req := &proto.ListExperimentsRequest{Namespace: namespace}
-	return m.client.ListExperiments(ctx, req)
+// Experiments iterates over the experiments of a namespace in the order they
+// were created.
+func (m *ModelBoxClient) Experiments(ctx context.Context, namespace string) *ExperimentIterator {
+	return m.QueryExperiments(namespace).Iterator(ctx)
 }
 
-func (m *ModelBoxClient) ListCheckpoints(experimentId string) (*proto.ListCheckpointsResponse, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), DEADLINE)
-	defer cancel()
-	req := &proto.ListCheckpointsRequest{ExperimentId: experimentId}
-	return m.client.ListCheckpoints(ctx, req)
-}
-
-func (m *ModelBoxClient) ClusterMembers() (*proto.GetClusterMembersResponse, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), DEADLINE)
-	defer cancel()
-
-	req := &proto.GetClusterMembersRequest{}
-	return m.client.GetClusterMembers(ctx, req)
+func (m *ModelBoxClient) ListExperiments(ctx context.Context, namespace string) ([]*Experiment, error) {
+	return m.Experiments(ctx, namespace).All()
 }
 
-func (m *ModelBoxClient) CreateAction(name, arch, object, params string) (*proto.CreateActionResponse, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), DEADLINE)
+func (m *ModelBoxClient) GetExperiment(ctx context.Context, id string) (*Experiment, error) {
+	ctx, cancel := withDefaultDeadline(ctx, m.opts.timeout)
 	defer cancel()
-
-	req := &proto.CreateActionRequest{
-		Name:     name,
-		Arch:     arch,
-		ObjectId: object,
-		//	Params: params,
+	req := &proto.GetExperimentRequest{Id: id}
+	resp, err := m.client.GetExperiment(ctx, req)
+	if err != nil {
+		return nil, wrapError("get experiment", err)
 	}
-	return m.client.CreateActions(ctx, req)
-}
-
-func (m *ModelBoxClient) ListActions(objectId string) (*proto.ListActionsResponse, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), DEADLINE)
-	defer cancel()
-
-	req := &proto.ListActionsRequest{ObjectId: objectId}
-	return m.client.ListActions(ctx, req)
+	return experimentFromProto(resp.Experiment), nil
 }
 
-func (m *ModelBoxClient) CreateModel(name, owner, namespace, task, description string, metadata map[string]string, files []*proto.FileMetadata) (*CreateModelApiResponse, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), DEADLINE)
+func (m *ModelBoxClient) CreateModel(ctx context.Context, name, owner, namespace, task, description string) (*CreateModelApiResponse, error) {
+	ctx, cancel := withDefaultDeadline(ctx, m.opts.timeout)
 	defer cancel()
 	req := &proto.CreateModelRequest{
-		Name:        name,
-		Owner:       owner,
-		Namespace:   namespace,
-		Task:        task,
-		Description: description,
-		Files:       files,
+		Name:           name,
+		Owner:          m.opts.ownerOrDefault(owner),
+		Namespace:      m.opts.namespaceOrDefault(namespace),
+		Task:           task,
+		Description:    description,
+		IdempotencyKey: newIdempotencyKey(),
 	}
 
 	resp, err := m.client.CreateModel(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("unable to create model: %v", err)
+		return nil, wrapError("create model", err)
 	}
-	return &CreateModelApiResponse{Id: resp.Id}, nil
+	return &CreateModelApiResponse{
+		Id:        resp.Id,
+		Exists:    resp.Exists,
+		CreatedAt: toTime(resp.CreatedAt),
+		UpdatedAt: toTime(resp.UpdatedAt),
+	}, nil
+}
+
+// Models iterates over the models of a namespace in the order they were
+// created.
+func (m *ModelBoxClient) Models(ctx context.Context, namespace string) *ModelIterator {
+	return m.QueryModels(namespace).Iterator(ctx)
 }
 
-func (m *ModelBoxClient) ListModels(namespace string) ([]*proto.Model, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), DEADLINE)
+func (m *ModelBoxClient) ListModels(ctx context.Context, namespace string) ([]*Model, error) {
+	return m.Models(ctx, namespace).All()
+}
+
+func (m *ModelBoxClient) CreateModelVersion(ctx context.Context, modelId, name, version, description, namespace, framework string, uniqueTags []string) (*CreateModelVersionResponse, error) {
+	ctx, cancel := withDefaultDeadline(ctx, m.opts.timeout)
 	defer cancel()
-	req := &proto.ListModelsRequest{
-		Namespace: namespace,
+	req := &proto.CreateModelVersionRequest{
+		Model:          modelId,
+		Name:           name,
+		Version:        version,
+		Description:    description,
+		Namespace:      m.opts.namespaceOrDefault(namespace),
+		Framework:      MLFrameworkProtoFromStr(framework),
+		UniqueTags:     uniqueTags,
+		IdempotencyKey: newIdempotencyKey(),
 	}
-
-	resp, err := m.client.ListModels(ctx, req)
+	resp, err := m.client.CreateModelVersion(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, wrapError("create model version", err)
 	}
-	return resp.Models, nil
+	return &CreateModelVersionResponse{
+		Id:        resp.ModelVersion,
+		Exists:    resp.Exists,
+		CreatedAt: toTime(resp.CreatedAt),
+		UpdatedAt: toTime(resp.UpdatedAt),
+	}, nil
 }
 
-type ApiCreateCheckpoint struct {
-	ExperimentId string
-	Epoch        uint64
-	Path         string
+// ModelVersions iterates over the versions of a model in the order they were
+// created.
+func (m *ModelBoxClient) ModelVersions(ctx context.Context, modelId string) *ModelVersionIterator {
+	it := &ModelVersionIterator{}
+	req := &proto.ListModelVersionsRequest{Model: modelId, PageSize: uint32(m.opts.pageSize)}
+	it.pages = pager{ctx: ctx, fetch: func(ctx context.Context, token string) (string, error) {
+		ctx, cancel := withDefaultDeadline(ctx, m.opts.timeout)
+		defer cancel()
+		req.PageToken = token
+		resp, err := m.client.ListModelVersions(ctx, req)
+		if err != nil {
+			return "", wrapError("list model versions", err)
+		}
+		for _, mv := range resp.ModelVersions {
+			it.items = append(it.items, modelVersionFromProto(mv))
+		}
+		return resp.NextPageToken, nil
+	}}
+	return it
 }
 
-func (m *ModelBoxClient) CreateCheckpoint(chk *ApiCreateCheckpoint) (*proto.CreateCheckpointResponse, error) {
-	checkpointRequest := proto.CreateCheckpointRequest{
-		ExperimentId: chk.ExperimentId,
-		Epoch:        chk.Epoch,
-		Files: []*proto.FileMetadata{{
-			ParentId: chk.ExperimentId,
-			Path:     chk.Path,
-		}},
-	}
-	response, err := m.client.CreateCheckpoint(context.Background(), &checkpointRequest)
-	if err != nil {
-		return nil, err
-	}
-	return response, nil
+func (m *ModelBoxClient) ListModelVersions(ctx context.Context, modelId string) ([]*ModelVersion, error) {
+	return m.ModelVersions(ctx, modelId).All()
 }
 
-func (m *ModelBoxClient) UploadFile(path, parentId string, t artifacts.FileMIMEType) (*FileUploadResponse, error) {
-	// This makes us read the file twice, this could be simplified
-	// if we do bidirectional stream and send the
-	// checkpoint at the end of the strem to the server to validate the file
-	checksum, err := m.getChecksum(path)
-	if err != nil {
-		return nil, fmt.Errorf("unable to compute checksum: %v ", err)
-	}
-	f, err := os.Open(path)
-	if err != nil {
-		return nil, fmt.Errorf("unable to open file: %v", err)
-	}
-	defer f.Close()
-	ctx, cancel := context.WithTimeout(context.Background(), DEADLINE)
+// UpdateMetadata merges the given keys into the metadata of an object. Values
+// are serialized as JSON.
+func (m *ModelBoxClient) UpdateMetadata(ctx context.Context, parentId string, metadata map[string]interface{}) error {
+	ctx, cancel := withDefaultDeadline(ctx, m.opts.timeout)
 	defer cancel()
-	req := &proto.UploadFileRequest{
-		StreamFrame: &proto.UploadFileRequest_Metadata{
-			Metadata: &proto.FileMetadata{
-				ParentId: parentId,
-				FileType: server.FileTypeToProto(t),
-				Checksum: checksum,
-			},
-		},
-	}
-	stream, err := m.client.UploadFile(ctx)
+	encoded, err := encodeMetadata(metadata)
 	if err != nil {
-		return nil, fmt.Errorf("unable to create client stream %v", err)
-	}
-	if err := stream.Send(req); err != nil {
-		return nil, fmt.Errorf("unable to send metadata: %v", err)
+		return fmt.Errorf("unable to encode metadata: %v", err)
 	}
-	var recvMsg proto.UploadFileResponse
-	if err := stream.RecvMsg(&recvMsg); err != nil {
-		return nil, fmt.Errorf("unable to write metadata: %v", err)
+	req := &proto.UpdateMetadataRequest{
+		ParentId: parentId,
+		Metadata: &proto.Metadata{Metadata: encoded},
 	}
-	if recvMsg.FileId != "" {
-		return &FileUploadResponse{recvMsg.FileId, checksum}, nil
-	}
-	bytes := make([]byte, 1024000)
-	for {
-		n, e := f.Read(bytes)
-		if e == io.EOF {
-			break
-		}
-		if e != nil && e != io.EOF {
-			return nil, fmt.Errorf("unable to read file: %v", err)
-		}
-		req := &proto.UploadFileRequest{
-			StreamFrame: &proto.UploadFileRequest_Chunks{Chunks: bytes[:n]},
-		}
-		if err := stream.Send(req); err != nil {
-			return nil, fmt.Errorf("unable to upload chunks: %v", err)
-		}
-	}
-	resp, err := stream.CloseAndRecv()
+	_, err = m.client.UpdateMetadata(ctx, req)
+	return wrapError("update metadata", err)
+}
+
+// ListMetadata returns the metadata of an object, decoding values which were
+// serialized as JSON. Values which aren't valid JSON are returned as strings.
+func (m *ModelBoxClient) ListMetadata(ctx context.Context, parentId string) (map[string]interface{}, error) {
+	ctx, cancel := withDefaultDeadline(ctx, m.opts.timeout)
+	defer cancel()
+	req := &proto.ListMetadataRequest{ParentId: parentId}
+	resp, err := m.client.ListMetadata(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("unable to close stream: %v", err)
+		return nil, wrapError("list metadata", err)
 	}
-	return &FileUploadResponse{resp.FileId, checksum}, nil
+	return decodeMetadata(resp.GetMetadata().GetMetadata()), nil
 }
 
-func (m *ModelBoxClient) DownloadBlob(id, path string) (*CheckpointDownloadResponse, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), DEADLINE)
+// TrackArtifacts records files which are stored outside of ModelBox as an
+// artifact of an experiment, model or model version.
+func (m *ModelBoxClient) TrackArtifacts(ctx context.Context, name, objectId string, files []*FileMetadata) (*TrackArtifactsResponse, error) {
+	ctx, cancel := withDefaultDeadline(ctx, m.opts.timeout)
 	defer cancel()
-	req := &proto.DownloadFileRequest{
-		FileId: id,
+	protoFiles := make([]*proto.FileMetadata, 0, len(files))
+	for _, f := range files {
+		protoFiles = append(protoFiles, f.toProto())
 	}
-	h := md5.New()
-	stream, err := m.client.DownloadFile(ctx, req)
-	if err != nil {
-		return nil, err
+	req := &proto.TrackArtifactsRequest{
+		Name:     name,
+		ObjectId: objectId,
+		Files:    protoFiles,
 	}
-	f, err := os.Create(path)
+	resp, err := m.client.TrackArtifacts(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, wrapError("track artifacts", err)
 	}
-	defer f.Close()
+	return &TrackArtifactsResponse{Id: resp.Id}, nil
+}
 
-	resp, err := stream.Recv()
-	if err != nil {
-		return nil, err
-	}
-	for {
-		chunks, err := stream.Recv()
-		if err == io.EOF {
-			break
-		}
+// Artifacts iterates over the artifacts of an object in the order they were
+// created.
+func (m *ModelBoxClient) Artifacts(ctx context.Context, objectId string) *ArtifactIterator {
+	it := &ArtifactIterator{}
+	req := &proto.ListArtifactsRequest{ObjectId: objectId, PageSize: uint32(m.opts.pageSize)}
+	it.pages = pager{ctx: ctx, fetch: func(ctx context.Context, token string) (string, error) {
+		ctx, cancel := withDefaultDeadline(ctx, m.opts.timeout)
+		defer cancel()
+		req.PageToken = token
+		resp, err := m.client.ListArtifacts(ctx, req)
 		if err != nil {
-			return nil, err
+			return "", wrapError("list artifacts", err)
 		}
-		bytes := chunks.GetChunks()
-		_, err = f.Write(bytes)
-		h.Write(bytes)
-		if err != nil {
-			stream.CloseSend()
-			return nil, err
+		for _, a := range resp.Artifacts {
+			it.items = append(it.items, artifactFromProto(a))
 		}
+		return resp.NextPageToken, nil
+	}}
+	return it
+}
+
+func (m *ModelBoxClient) ListArtifacts(ctx context.Context, objectId string) ([]*Artifact, error) {
+	return m.Artifacts(ctx, objectId).All()
+}
+
+// LogMetrics logs a value of a metric. Values which aren't float32,
+// float64, string or []byte fail with ErrInvalidArgument.
+func (m *ModelBoxClient) LogMetrics(ctx context.Context, parentId, key string, value *MetricValue) error {
+	mv, err := value.toProto()
+	if err != nil {
+		return err
 	}
-	checksum := fmt.Sprintf("%x", h.Sum(nil))
-	serverChecksum := resp.GetMetadata().GetChecksum()
-	if checksum != serverChecksum {
-		return nil, fmt.Errorf("actual checksum %v, calculated checksum %v", serverChecksum, checksum)
+	ctx, cancel := withDefaultDeadline(ctx, m.opts.timeout)
+	defer cancel()
+	req := &proto.LogMetricsRequest{
+		ParentId:       parentId,
+		Key:            key,
+		Value:          mv,
+		IdempotencyKey: newIdempotencyKey(),
 	}
-	return &CheckpointDownloadResponse{Checksum: checksum, ServerChecksum: serverChecksum}, nil
+	_, err = m.client.LogMetrics(ctx, req)
+	return wrapError("log metrics", err)
 }
 
-func (m *ModelBoxClient) getChecksum(path string) (string, error) {
-	f, err := os.Open(path)
+// GetMetrics returns every value logged for an object, keyed by metric name.
+func (m *ModelBoxClient) GetMetrics(ctx context.Context, parentId string) (map[string][]*MetricValue, error) {
+	ctx, cancel := withDefaultDeadline(ctx, m.opts.timeout)
+	defer cancel()
+	req := &proto.GetMetricsRequest{ParentId: parentId}
+	resp, err := m.client.GetMetrics(ctx, req)
 	if err != nil {
-		return "", fmt.Errorf("error opening file: %v", err)
+		return nil, wrapError("get metrics", err)
 	}
-	defer f.Close()
-
-	h := md5.New()
-	if _, err := io.Copy(h, f); err != nil {
-		return "", fmt.Errorf("error reading file while calculating checksum: %v", err)
+	metrics := make(map[string][]*MetricValue, len(resp.Metrics))
+	for key, metric := range resp.Metrics {
+		values := make([]*MetricValue, 0, len(metric.Values))
+		for _, v := range metric.Values {
+			values = append(values, metricValueFromProto(v))
+		}
+		metrics[key] = values
 	}
-	return fmt.Sprintf("%x", h.Sum(nil)), nil
+	return metrics, nil
 }
 
-func (m *ModelBoxClient) StremChangeEvents(namespace string, cb func(*ChangeStreamEventResponse) error) error {
-	req := &proto.WatchNamespaceRequest{
-		Namespace: namespace,
-		Since:     uint64(time.Now().Unix()),
+func (m *ModelBoxClient) LogEvent(ctx context.Context, parentId string, event *Event) (*LogEventResponse, error) {
+	ctx, cancel := withDefaultDeadline(ctx, m.opts.timeout)
+	defer cancel()
+	req := &proto.LogEventRequest{
+		ParentId:       parentId,
+		Event:          event.toProto(),
+		IdempotencyKey: newIdempotencyKey(),
 	}
-
-	resp, err := m.client.WatchNamespace(context.Background(), req)
+	resp, err := m.client.LogEvent(ctx, req)
 	if err != nil {
-		return fmt.Errorf("unable to request change events: %v", err)
+		return nil, wrapError("log event", err)
 	}
-	for {
-		resp, err := resp.Recv()
+	return &LogEventResponse{CreatedAt: toTime(resp.CreatedAt)}, nil
+}
+
+// Events iterates over the events logged for an object after since, in the
+// order they happened. A zero since iterates over every event.
+func (m *ModelBoxClient) Events(ctx context.Context, parentId string, since time.Time) *EventIterator {
+	it := &EventIterator{}
+	req := &proto.ListEventsRequest{
+		ParentId: parentId,
+		Since:    &timestamppb.Timestamp{},
+		PageSize: uint32(m.opts.pageSize),
+	}
+	if !since.IsZero() {
+		req.Since = timestamppb.New(since)
+	}
+	it.pages = pager{ctx: ctx, fetch: func(ctx context.Context, token string) (string, error) {
+		ctx, cancel := withDefaultDeadline(ctx, m.opts.timeout)
+		defer cancel()
+		req.PageToken = token
+		resp, err := m.client.ListEvents(ctx, req)
 		if err != nil {
-			if err == io.EOF {
-				return nil
-			}
-			return fmt.Errorf("stream closed with error: %v", err)
+			return "", wrapError("list events", err)
 		}
+		for _, e := range resp.Events {
+			it.items = append(it.items, eventFromProto(e))
+		}
+		return resp.NextPageToken, nil
+	}}
+	return it
+}
+
+// ListEvents returns the events logged for an object after since. A zero
+// since returns every event.
+func (m *ModelBoxClient) ListEvents(ctx context.Context, parentId string, since time.Time) ([]*Event, error) {
+	return m.Events(ctx, parentId, since).All()
+}
+
+// StremChangeEvents calls cb with the changes of a namespace until ctx is
+// canceled or cb returns an error.
+//
+// Deprecated: use Watch, which also exposes the position of events.
+func (m *ModelBoxClient) StremChangeEvents(ctx context.Context, namespace string, cb func(*ChangeStreamEventResponse) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	w := m.Watch(ctx, namespace)
+	for event := range w.Events() {
 		streamEvent := &ChangeStreamEventResponse{
-			Event:   1,
-			PayLoad: resp.Payload,
+			Event:   uint8(event.Type),
+			PayLoad: event.Payload,
 		}
 		if err := cb(streamEvent); err != nil {
-			return fmt.Errorf("cb error: %v", err)
+			return fmt.Errorf("cb error: %w", err)
 		}
 	}
+	return w.Err()
 }
@@ -1,34 +1,293 @@
 package client
 
 import (
+	"errors"
+	"fmt"
+	"io/fs"
 	"net"
 	"os"
+	"path/filepath"
+	"strconv"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
 
+const (
+	DEFAULT_SERVER_ADDR = "localhost:8085"
+
+	// Environment variables overriding the client config.
+	ENV_CONFIG          = "MODELBOX_CONFIG"
+	ENV_PROFILE         = "MODELBOX_PROFILE"
+	ENV_SERVER_ADDR     = "MODELBOX_SERVER_ADDR"
+	ENV_NAMESPACE       = "MODELBOX_NAMESPACE"
+	ENV_OWNER           = "MODELBOX_OWNER"
+	ENV_TIMEOUT         = "MODELBOX_TIMEOUT"
+	ENV_TLS             = "MODELBOX_TLS"
+	ENV_TLS_CA_FILE     = "MODELBOX_TLS_CA_FILE"
+	ENV_TLS_CERT_FILE   = "MODELBOX_TLS_CERT_FILE"
+	ENV_TLS_KEY_FILE    = "MODELBOX_TLS_KEY_FILE"
+	ENV_TLS_SERVER_NAME = "MODELBOX_TLS_SERVER_NAME"
+	ENV_BEARER_TOKEN    = "MODELBOX_BEARER_TOKEN"
+	ENV_API_KEY         = "MODELBOX_API_KEY"
+)
+
+type TLSConfig struct {
+	Enabled            bool   `yaml:"enabled"`
+	CAFile             string `yaml:"ca_file"`
+	CertFile           string `yaml:"cert_file"`
+	KeyFile            string `yaml:"key_file"`
+	ServerName         string `yaml:"server_name"`
+	InsecureSkipVerify bool   `yaml:"insecure_skip_verify"`
+}
+
+type AuthConfig struct {
+	BearerToken string `yaml:"bearer_token"`
+	APIKey      string `yaml:"api_key"`
+	// InsecureTokens sends the token and the key over connections without
+	// TLS, see WithInsecureTokens.
+	InsecureTokens bool `yaml:"insecure_tokens"`
+}
+
+type KeepaliveConfig struct {
+	Time                time.Duration `yaml:"time"`
+	Timeout             time.Duration `yaml:"timeout"`
+	PermitWithoutStream bool          `yaml:"permit_without_stream"`
+}
+
+// ClientConfig describes how to reach a ModelBox server. A config file holds a
+// top level ClientConfig which every profile inherits and can override.
 type ClientConfig struct {
-	ServerAddr string `yaml:"server_addr"`
+	ServerAddr        string           `yaml:"server_addr"`
+	Namespace         string           `yaml:"namespace"`
+	Owner             string           `yaml:"owner"`
+	Timeout           time.Duration    `yaml:"timeout"`
+	Retry             *RetryPolicy     `yaml:"retry"`
+	TLS               *TLSConfig       `yaml:"tls"`
+	Auth              *AuthConfig      `yaml:"auth"`
+	UserAgent         string           `yaml:"user_agent"`
+	Keepalive         *KeepaliveConfig `yaml:"keepalive"`
+	MaxRecvMsgSize    int              `yaml:"max_recv_msg_size"`
+	MaxSendMsgSize    int              `yaml:"max_send_msg_size"`
+	ChecksumAlgorithm string           `yaml:"checksum_algorithm"`
+	PageSize          int              `yaml:"page_size"`
 }
 
+type configFile struct {
+	DefaultProfile string               `yaml:"default_profile"`
+	Profiles       map[string]yaml.Node `yaml:"profiles"`
+}
+
+// NewClientConfig reads the config file at configPath, selecting the file's
+// default profile if it has one.
 func NewClientConfig(configPath string) (*ClientConfig, error) {
+	config, err := readClientConfig(configPath, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// DefaultConfigPath is the config file used when neither a path nor
+// MODELBOX_CONFIG is provided, ~/.modelbox/config.yaml.
+func DefaultConfigPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".modelbox", "config.yaml")
+}
+
+// LoadClientConfig resolves the client config the same way for every tool.
+// The config file is configPath if set, else $MODELBOX_CONFIG, else
+// DefaultConfigPath; a missing default file is not an error. The profile is
+// chosen the same way from profile, $MODELBOX_PROFILE and the file's
+// default_profile. MODELBOX_* environment variables override the values read
+// from the file.
+func LoadClientConfig(configPath, profile string) (*ClientConfig, error) {
+	if configPath == "" {
+		configPath = os.Getenv(ENV_CONFIG)
+	}
+	if profile == "" {
+		profile = os.Getenv(ENV_PROFILE)
+	}
+	config := &ClientConfig{}
+	if configPath != "" {
+		var err error
+		if config, err = readClientConfig(configPath, profile); err != nil {
+			return nil, err
+		}
+	} else if path := DefaultConfigPath(); path != "" {
+		c, err := readClientConfig(path, profile)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		if err == nil {
+			config = c
+		}
+	}
+	if err := config.applyEnv(); err != nil {
+		return nil, err
+	}
+	if config.ServerAddr == "" {
+		config.ServerAddr = DEFAULT_SERVER_ADDR
+	}
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func readClientConfig(configPath, profile string) (*ClientConfig, error) {
 	bytes, err := os.ReadFile(configPath)
 	if err != nil {
 		return nil, err
 	}
+	var file configFile
+	if err := yaml.Unmarshal(bytes, &file); err != nil {
+		return nil, err
+	}
 	var config ClientConfig
 	if err := yaml.Unmarshal(bytes, &config); err != nil {
 		return nil, err
 	}
-	if err := config.validate(); err != nil {
-		return nil, err
+	if profile == "" {
+		profile = file.DefaultProfile
+	}
+	if profile == "" {
+		return &config, nil
+	}
+	node, ok := file.Profiles[profile]
+	if !ok {
+		return nil, fmt.Errorf("profile %v not found in %v", profile, configPath)
+	}
+	// Decoding over the top level config only replaces the keys set by the
+	// profile.
+	if err := node.Decode(&config); err != nil {
+		return nil, fmt.Errorf("unable to read profile %v: %v", profile, err)
 	}
 	return &config, nil
 }
 
+func (c *ClientConfig) applyEnv() error {
+	setStr := func(env string, dst *string) {
+		if v, ok := os.LookupEnv(env); ok {
+			*dst = v
+		}
+	}
+	setStr(ENV_SERVER_ADDR, &c.ServerAddr)
+	setStr(ENV_NAMESPACE, &c.Namespace)
+	setStr(ENV_OWNER, &c.Owner)
+	if v, ok := os.LookupEnv(ENV_TIMEOUT); ok {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("invalid %v: %v", ENV_TIMEOUT, err)
+		}
+		c.Timeout = d
+	}
+	if c.TLS == nil {
+		c.TLS = &TLSConfig{}
+	}
+	if v, ok := os.LookupEnv(ENV_TLS); ok {
+		enabled, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("invalid %v: %v", ENV_TLS, err)
+		}
+		c.TLS.Enabled = enabled
+	}
+	setStr(ENV_TLS_CA_FILE, &c.TLS.CAFile)
+	setStr(ENV_TLS_CERT_FILE, &c.TLS.CertFile)
+	setStr(ENV_TLS_KEY_FILE, &c.TLS.KeyFile)
+	setStr(ENV_TLS_SERVER_NAME, &c.TLS.ServerName)
+	if c.Auth == nil {
+		c.Auth = &AuthConfig{}
+	}
+	setStr(ENV_BEARER_TOKEN, &c.Auth.BearerToken)
+	setStr(ENV_API_KEY, &c.Auth.APIKey)
+	return nil
+}
+
+// Options returns the client options described by the config.
+func (c *ClientConfig) Options() []ClientOption {
+	var opts []ClientOption
+	if c.TLS != nil && c.TLS.Enabled {
+		opts = append(opts, WithTLS(c.TLS.CAFile))
+		if c.TLS.CertFile != "" {
+			opts = append(opts, WithClientCertificate(c.TLS.CertFile, c.TLS.KeyFile))
+		}
+		if c.TLS.ServerName != "" {
+			opts = append(opts, WithServerName(c.TLS.ServerName))
+		}
+		if c.TLS.InsecureSkipVerify {
+			opts = append(opts, WithInsecureSkipVerify())
+		}
+	}
+	if c.Auth != nil {
+		if c.Auth.BearerToken != "" {
+			opts = append(opts, WithBearerToken(c.Auth.BearerToken))
+		}
+		if c.Auth.APIKey != "" {
+			opts = append(opts, WithAPIKey(c.Auth.APIKey))
+		}
+		if c.Auth.InsecureTokens {
+			opts = append(opts, WithInsecureTokens())
+		}
+	}
+	if c.UserAgent != "" {
+		opts = append(opts, WithUserAgent(c.UserAgent))
+	}
+	if c.Keepalive != nil {
+		opts = append(opts, WithKeepalive(c.Keepalive.Time, c.Keepalive.Timeout, c.Keepalive.PermitWithoutStream))
+	}
+	if c.MaxRecvMsgSize > 0 || c.MaxSendMsgSize > 0 {
+		opts = append(opts, WithMaxMessageSize(c.MaxRecvMsgSize, c.MaxSendMsgSize))
+	}
+	if c.Timeout > 0 {
+		opts = append(opts, WithDefaultTimeout(c.Timeout))
+	}
+	if c.Retry != nil {
+		opts = append(opts, WithRetryPolicy(c.Retry))
+	}
+	if algorithm, err := ChecksumAlgorithmFromStr(c.ChecksumAlgorithm); err == nil {
+		opts = append(opts, WithChecksumAlgorithm(algorithm))
+	}
+	if c.PageSize > 0 {
+		opts = append(opts, WithPageSize(c.PageSize))
+	}
+	if c.Namespace != "" {
+		opts = append(opts, WithDefaultNamespace(c.Namespace))
+	}
+	if c.Owner != "" {
+		opts = append(opts, WithDefaultOwner(c.Owner))
+	}
+	return opts
+}
+
 func (c *ClientConfig) validate() error {
 	if _, _, err := net.SplitHostPort(c.ServerAddr); err != nil {
 		return err
 	}
+	if c.TLS != nil && c.TLS.Enabled && (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+		return fmt.Errorf("tls cert_file and key_file must be set together")
+	}
+	if c.MaxRecvMsgSize < 0 || c.MaxSendMsgSize < 0 {
+		return fmt.Errorf("max message sizes can't be negative")
+	}
+	if c.ChecksumAlgorithm != "" {
+		if _, err := ChecksumAlgorithmFromStr(c.ChecksumAlgorithm); err != nil {
+			return err
+		}
+	}
+	if c.Timeout < 0 {
+		return fmt.Errorf("timeout can't be negative")
+	}
+	if c.Retry != nil {
+		if err := c.Retry.validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -1,7 +1,10 @@
 package client
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -11,3 +14,107 @@ func TestNewClientConfig(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, ":8085", config.ServerAddr)
 }
+
+func writeConfig(t *testing.T, dir, contents string) string {
+	path := filepath.Join(dir, "config.yaml")
+	assert.Nil(t, os.WriteFile(path, []byte(contents), 0600))
+	return path
+}
+
+const profilesConfig = `
+server_addr: "localhost:8085"
+namespace: research
+timeout: 5s
+retry:
+  max_attempts: 4
+  initial_backoff: 100ms
+  max_backoff: 5s
+tls:
+  enabled: true
+  server_name: modelbox.internal
+default_profile: staging
+profiles:
+  staging:
+    server_addr: "staging.internal:443"
+  prod:
+    server_addr: "prod.internal:443"
+    namespace: production
+    retry:
+      max_attempts: 6
+    tls:
+      ca_file: /etc/modelbox/ca.pem
+`
+
+func TestClientConfigProfiles(t *testing.T) {
+	path := writeConfig(t, t.TempDir(), profilesConfig)
+
+	config, err := NewClientConfig(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "staging.internal:443", config.ServerAddr)
+	assert.Equal(t, "research", config.Namespace)
+
+	config, err = LoadClientConfig(path, "prod")
+	assert.Nil(t, err)
+	assert.Equal(t, "prod.internal:443", config.ServerAddr)
+	assert.Equal(t, "production", config.Namespace)
+	assert.Equal(t, 5*time.Second, config.Timeout)
+	// Nested settings are merged with the top level ones
+	assert.Equal(t, "modelbox.internal", config.TLS.ServerName)
+	assert.Equal(t, "/etc/modelbox/ca.pem", config.TLS.CAFile)
+	assert.Equal(t, 6, config.Retry.MaxAttempts)
+	assert.Equal(t, 100*time.Millisecond, config.Retry.InitialBackoff)
+	assert.Equal(t, 5*time.Second, config.Retry.MaxBackoff)
+	assert.Equal(t, DEFAULT_RETRY_JITTER, config.Retry.Jitter)
+
+	_, err = LoadClientConfig(path, "missing")
+	assert.NotNil(t, err)
+}
+
+func TestLoadClientConfigDiscovery(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv(ENV_CONFIG, "")
+	t.Setenv(ENV_PROFILE, "")
+
+	// Nothing configured
+	config, err := LoadClientConfig("", "")
+	assert.Nil(t, err)
+	assert.Equal(t, DEFAULT_SERVER_ADDR, config.ServerAddr)
+
+	assert.Nil(t, os.MkdirAll(filepath.Join(home, ".modelbox"), 0700))
+	writeConfig(t, filepath.Join(home, ".modelbox"), profilesConfig)
+	config, err = LoadClientConfig("", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "staging.internal:443", config.ServerAddr)
+
+	envPath := writeConfig(t, t.TempDir(), `server_addr: "env.internal:8085"`)
+	t.Setenv(ENV_CONFIG, envPath)
+	config, err = LoadClientConfig("", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "env.internal:8085", config.ServerAddr)
+
+	config, err = LoadClientConfig("../cmd/modelbox/assets/modelbox_client.yaml", "dev")
+	assert.Nil(t, err)
+	assert.Equal(t, "localhost:8085", config.ServerAddr)
+}
+
+func TestLoadClientConfigEnvOverrides(t *testing.T) {
+	path := writeConfig(t, t.TempDir(), profilesConfig)
+	t.Setenv(ENV_PROFILE, "prod")
+	t.Setenv(ENV_SERVER_ADDR, "override.internal:443")
+	t.Setenv(ENV_OWNER, "ci-bot")
+	t.Setenv(ENV_TIMEOUT, "1m")
+	t.Setenv(ENV_BEARER_TOKEN, "secret")
+
+	config, err := LoadClientConfig(path, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "override.internal:443", config.ServerAddr)
+	assert.Equal(t, "production", config.Namespace)
+	assert.Equal(t, "ci-bot", config.Owner)
+	assert.Equal(t, time.Minute, config.Timeout)
+	assert.Equal(t, "secret", config.Auth.BearerToken)
+
+	t.Setenv(ENV_TIMEOUT, "soon")
+	_, err = LoadClientConfig(path, "")
+	assert.NotNil(t, err)
+}
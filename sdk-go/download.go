@@ -0,0 +1,285 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/tensorland/modelbox/sdk-go/proto"
+)
+
+// errRestartDownload is returned by download when the partial download
+// it was asked to continue can't be resumed.
+var errRestartDownload = errors.New("partial download can't be resumed")
+
+// partialPath is where a download to path is written until it is verified.
+// It is kept in the same directory so that it can be renamed atomically.
+func partialPath(path, id string) string {
+	dir, base := filepath.Split(path)
+	return filepath.Join(dir, fmt.Sprintf(".%v.%v.part", base, id))
+}
+
+// DownloadBlob downloads a file stored by ModelBox to path and verifies its
+// checksum. The file is written next to path and only renamed to path once
+// it is synced and verified, so path never holds a partial or corrupt file. If
+// a download is interrupted, the next call continues it from where it stopped.
+// With WithParallelTransfers files larger than the part size are downloaded as
+// ranges over concurrent streams.
+func (m *ModelBoxClient) DownloadBlob(ctx context.Context, id, path string) (*CheckpointDownloadResponse, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	partial := partialPath(path, id)
+	f, err := os.OpenFile(partial, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	offset := uint64(info.Size())
+	resp, err := m.downloadTo(ctx, id, f, offset)
+	if errors.Is(err, errRestartDownload) {
+		if err = f.Truncate(0); err == nil {
+			resp, err = m.downloadTo(ctx, id, f, 0)
+		}
+	}
+	if err != nil {
+		// a corrupt file or one with parts missing can't be resumed
+		if errors.Is(err, ErrChecksumMismatch) || m.opts.transferWorkers > 1 {
+			f.Close()
+			os.Remove(partial)
+		}
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(partial, path); err != nil {
+		return nil, err
+	}
+	// the rename is only durable once the directory is synced
+	if dir, err := os.Open(filepath.Dir(path)); err == nil {
+		dir.Sync()
+		dir.Close()
+	}
+	return resp, nil
+}
+
+// Download streams a file stored by ModelBox without touching the local disk.
+// The contents are checksummed as they are read, and the reader fails with an
+// error matching ErrChecksumMismatch instead of io.EOF if the file is corrupt.
+// The reader must be closed to release the stream.
+func (m *ModelBoxClient) Download(ctx context.Context, id string) (io.ReadCloser, *FileMetadata, error) {
+	r, err := m.download(ctx, id, 0, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	return r, fileMetadataFromProto(r.meta), nil
+}
+
+// download opens a stream reading length bytes of the file from offset, or
+// the rest of the file when length is 0, and waits for the file metadata.
+// Ranges past the start of the file need a server which reports the file
+// size, else errRestartDownload is returned.
+func (m *ModelBoxClient) download(ctx context.Context, id string, offset, length uint64) (*blobReader, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := m.client.DownloadFile(ctx, &proto.DownloadFileRequest{
+		FileId: id,
+		Offset: offset,
+		Length: length,
+	})
+	if err != nil {
+		cancel()
+		return nil, wrapError("download file", err)
+	}
+	resp, err := stream.Recv()
+	if err != nil {
+		cancel()
+		return nil, wrapError("download file", err)
+	}
+	meta := resp.GetMetadata()
+	if meta == nil {
+		cancel()
+		return nil, fmt.Errorf("no metadata received for file %v", id)
+	}
+	if offset > 0 && (meta.GetSize() == 0 || offset > meta.GetSize()) {
+		cancel()
+		return nil, errRestartDownload
+	}
+	r := &blobReader{stream: stream, cancel: cancel, id: id, meta: meta, pos: offset}
+	// only the whole file can be checked against its checksum
+	if offset == 0 && length == 0 {
+		r.hash = ChecksumAlgorithmFromProto(meta.GetChecksumAlgorithm()).New()
+	}
+	return r, nil
+}
+
+// blobReader reads the contents of a DownloadFile stream.
+type blobReader struct {
+	stream proto.ModelStore_DownloadFileClient
+	cancel context.CancelFunc
+	id     string
+	meta   *proto.FileMetadata
+	// pos is the offset in the file of the next chunk
+	pos  uint64
+	buf  []byte
+	hash hash.Hash
+	err  error
+}
+
+func (r *blobReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		r.buf, r.err = r.next()
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// next receives the next chunk of the file. At the end of the stream the file
+// is verified if it was read from the start.
+func (r *blobReader) next() ([]byte, error) {
+	resp, err := r.stream.Recv()
+	if err == io.EOF {
+		return nil, r.verify()
+	}
+	if err != nil {
+		return nil, wrapError("download file", err)
+	}
+	var data []byte
+	switch frame := resp.StreamFrame.(type) {
+	case *proto.DownloadFileResponse_Chunks:
+		data = frame.Chunks
+	case *proto.DownloadFileResponse_Chunk:
+		chunk := frame.Chunk
+		if chunk.Offset != r.pos || crc32.Checksum(chunk.Data, crc32c) != chunk.Crc32C {
+			return nil, &ChunkError{FileId: r.id, Offset: chunk.Offset, Expected: r.pos}
+		}
+		data = chunk.Data
+	}
+	if r.hash != nil {
+		r.hash.Write(data)
+	}
+	r.pos += uint64(len(data))
+	return data, nil
+}
+
+func (r *blobReader) verify() error {
+	if r.hash == nil {
+		return io.EOF
+	}
+	checksum := hex.EncodeToString(r.hash.Sum(nil))
+	if checksum != r.meta.GetChecksum() {
+		return &ChecksumMismatchError{
+			FileId:    r.id,
+			Algorithm: ChecksumAlgorithmFromProto(r.meta.GetChecksumAlgorithm()),
+			Expected:  r.meta.GetChecksum(),
+			Actual:    checksum,
+		}
+	}
+	return io.EOF
+}
+
+// verified reports whether the whole file was read and matched its checksum.
+func (r *blobReader) verified() bool {
+	return r.hash != nil && r.err == io.EOF
+}
+
+func (r *blobReader) Close() error {
+	r.cancel()
+	return nil
+}
+
+// offsetWriter writes to w from offset onwards.
+type offsetWriter struct {
+	w      io.WriterAt
+	offset int64
+}
+
+func (o *offsetWriter) Write(p []byte) (int, error) {
+	n, err := o.w.WriteAt(p, o.offset)
+	o.offset += int64(n)
+	return n, err
+}
+
+// downloadTo downloads the file to f from offset, syncs and verifies it.
+func (m *ModelBoxClient) downloadTo(ctx context.Context, id string, f *os.File, offset uint64) (*CheckpointDownloadResponse, error) {
+	// The first range returns the metadata of the file which tells whether
+	// the rest is worth splitting into parts. Servers which don't support
+	// ranges send the whole file.
+	var length uint64
+	if m.opts.transferWorkers > 1 {
+		length = m.opts.partSize
+	}
+	r, err := m.download(ctx, id, offset, length)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	received, err := io.Copy(&offsetWriter{w: f, offset: int64(offset)}, r)
+	if err != nil {
+		return nil, err
+	}
+	meta := r.meta
+	algorithm := ChecksumAlgorithmFromProto(meta.GetChecksumAlgorithm())
+	size := offset + uint64(received)
+	if end := size; length > 0 && uint64(received) == length && meta.GetSize() > end {
+		parts := splitParts(meta.GetSize()-end, m.opts.partSize)
+		for i := range parts {
+			parts[i].offset += end
+		}
+		err := runParts(ctx, m.opts.transferWorkers, parts, func(ctx context.Context, part transferPart) error {
+			pr, err := m.download(ctx, id, part.offset, part.size)
+			if err != nil {
+				return err
+			}
+			defer pr.Close()
+			n, err := io.Copy(&offsetWriter{w: f, offset: int64(part.offset)}, pr)
+			if err == nil && uint64(n) != part.size {
+				err = fmt.Errorf("received %v bytes of part %v, expected %v", n, part.number, part.size)
+			}
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		size = meta.GetSize()
+	}
+	if err := f.Sync(); err != nil {
+		return nil, err
+	}
+	checksum := meta.GetChecksum()
+	// parts arrive out of order and resumed downloads only received the end
+	// of the file, so the file is hashed once it is complete
+	if !r.verified() {
+		h := algorithm.New()
+		if _, err := io.Copy(h, io.NewSectionReader(f, 0, int64(size))); err != nil {
+			return nil, err
+		}
+		checksum = hex.EncodeToString(h.Sum(nil))
+	}
+	if checksum != meta.GetChecksum() {
+		return nil, &ChecksumMismatchError{
+			FileId:    id,
+			Algorithm: algorithm,
+			Expected:  meta.GetChecksum(),
+			Actual:    checksum,
+		}
+	}
+	return &CheckpointDownloadResponse{
+		Checksum:          checksum,
+		ServerChecksum:    meta.GetChecksum(),
+		ChecksumAlgorithm: algorithm,
+	}, nil
+}
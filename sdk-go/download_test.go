@@ -0,0 +1,222 @@
+package client
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const downloadChunkSize = 1024
+
+func (s *uploadServer) DownloadFile(req *proto.DownloadFileRequest, stream proto.ModelStore_DownloadFileServer) error {
+	s.mu.Lock()
+	file, ok := s.files[req.FileId]
+	algorithm := s.fileChecksums[req.FileId]
+	s.downloadOffsets = append(s.downloadOffsets, req.Offset)
+	legacy := s.legacyDownloads
+	corrupt := s.corruptDownloads
+	corruptChunks, misplacedChunks := s.corruptChunks, s.misplacedChunks
+	s.mu.Unlock()
+	if !ok {
+		return status.Error(codes.NotFound, "file not found")
+	}
+	h := algorithm.New()
+	h.Write(file)
+	meta := &proto.FileMetadata{
+		Id:                req.FileId,
+		Checksum:          hex.EncodeToString(h.Sum(nil)),
+		ChecksumAlgorithm: algorithm.ToProto(),
+		Size:              uint64(len(file)),
+	}
+	if legacy {
+		meta.Size = 0
+	}
+	if corrupt {
+		file = append([]byte{file[0] ^ 0xff}, file[1:]...)
+	}
+	if err := stream.Send(&proto.DownloadFileResponse{
+		StreamFrame: &proto.DownloadFileResponse_Metadata{Metadata: meta},
+	}); err != nil {
+		return err
+	}
+	if legacy {
+		return stream.Send(&proto.DownloadFileResponse{
+			StreamFrame: &proto.DownloadFileResponse_Chunks{Chunks: file},
+		})
+	}
+	end := uint64(len(file))
+	if req.Length > 0 && req.Offset+req.Length < end {
+		end = req.Offset + req.Length
+	}
+	for offset := req.Offset; offset < end; offset += downloadChunkSize {
+		data := file[offset:end]
+		if len(data) > downloadChunkSize {
+			data = data[:downloadChunkSize]
+		}
+		chunk := &proto.FileChunk{Offset: offset, Data: data, Crc32C: crc32.Checksum(data, crc32c)}
+		if corruptChunks {
+			chunk.Crc32C++
+		}
+		if misplacedChunks {
+			chunk.Offset++
+		}
+		if err := stream.Send(&proto.DownloadFileResponse{
+			StreamFrame: &proto.DownloadFileResponse_Chunk{Chunk: chunk},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestDownloadBlob(t *testing.T) {
+	for name, test := range map[string]struct {
+		algorithm ChecksumAlgorithm
+		opts      []ClientOption
+	}{
+		"single stream": {ChecksumSHA256, nil},
+		"parallel":      {ChecksumXXHash64, []ClientOption{WithParallelTransfers(3, 3*1024)}},
+		"legacy md5":    {ChecksumMD5, nil},
+	} {
+		t.Run(name, func(t *testing.T) {
+			server := newUploadServer(0)
+			data := make([]byte, 10*1024+17)
+			for i := range data {
+				data[i] = byte(i % 251)
+			}
+			server.files["file-1"] = data
+			server.fileChecksums["file-1"] = test.algorithm
+			client := newUploadTestClient(t, server, test.opts...)
+
+			path := filepath.Join(t.TempDir(), "model.pt")
+			resp, err := client.DownloadBlob(context.Background(), "file-1", path)
+			assert.Nil(t, err)
+			assert.Equal(t, test.algorithm, resp.ChecksumAlgorithm)
+			checksum, err := FileChecksum(path, test.algorithm)
+			assert.Nil(t, err)
+			assert.Equal(t, checksum, resp.Checksum)
+			downloaded, err := os.ReadFile(path)
+			assert.Nil(t, err)
+			assert.Equal(t, data, downloaded)
+		})
+	}
+}
+
+func newDownloadTestServer() (*uploadServer, []byte) {
+	server := newUploadServer(0)
+	data := make([]byte, 10*1024+17)
+	for i := range data {
+		data[i] = byte(i % 251)
+	}
+	server.files["file-1"] = data
+	server.fileChecksums["file-1"] = ChecksumSHA256
+	return server, data
+}
+
+func TestDownloadBlobResumesPartialFile(t *testing.T) {
+	server, data := newDownloadTestServer()
+	client := newUploadTestClient(t, server)
+	path := filepath.Join(t.TempDir(), "model.pt")
+	assert.Nil(t, os.WriteFile(partialPath(path, "file-1"), data[:4000], 0644))
+
+	_, err := client.DownloadBlob(context.Background(), "file-1", path)
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{4000}, server.downloadOffsets)
+	downloaded, err := os.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, data, downloaded)
+	_, err = os.Stat(partialPath(path, "file-1"))
+	assert.True(t, errors.Is(err, os.ErrNotExist))
+}
+
+func TestDownloadBlobRestartsWithoutRanges(t *testing.T) {
+	server, data := newDownloadTestServer()
+	server.legacyDownloads = true
+	client := newUploadTestClient(t, server)
+	path := filepath.Join(t.TempDir(), "model.pt")
+	assert.Nil(t, os.WriteFile(partialPath(path, "file-1"), []byte("stale"), 0644))
+
+	_, err := client.DownloadBlob(context.Background(), "file-1", path)
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{5, 0}, server.downloadOffsets)
+	downloaded, err := os.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, data, downloaded)
+}
+
+func TestDownloadBlobChecksumMismatch(t *testing.T) {
+	server, _ := newDownloadTestServer()
+	client := newUploadTestClient(t, server)
+	path := filepath.Join(t.TempDir(), "model.pt")
+	// a partial file which doesn't match the start of the file
+	assert.Nil(t, os.WriteFile(partialPath(path, "file-1"), []byte("corrupt"), 0644))
+
+	_, err := client.DownloadBlob(context.Background(), "file-1", path)
+	assert.True(t, errors.Is(err, ErrChecksumMismatch))
+	var mismatch *ChecksumMismatchError
+	assert.ErrorAs(t, err, &mismatch)
+	assert.Equal(t, ChecksumSHA256, mismatch.Algorithm)
+	assert.NotEqual(t, mismatch.Expected, mismatch.Actual)
+	for _, p := range []string{path, partialPath(path, "file-1")} {
+		_, err = os.Stat(p)
+		assert.True(t, errors.Is(err, os.ErrNotExist))
+	}
+}
+
+func TestDownload(t *testing.T) {
+	server, data := newDownloadTestServer()
+	client := newUploadTestClient(t, server)
+
+	r, meta, err := client.Download(context.Background(), "file-1")
+	assert.Nil(t, err)
+	defer r.Close()
+	assert.Equal(t, uint64(len(data)), meta.Size)
+	assert.Equal(t, ChecksumSHA256, meta.ChecksumAlgorithm)
+	downloaded, err := io.ReadAll(r)
+	assert.Nil(t, err)
+	assert.Equal(t, data, downloaded)
+}
+
+func TestDownloadChecksumMismatch(t *testing.T) {
+	server, _ := newDownloadTestServer()
+	server.corruptDownloads = true
+	client := newUploadTestClient(t, server)
+	r, _, err := client.Download(context.Background(), "file-1")
+	assert.Nil(t, err)
+	defer r.Close()
+	_, err = io.ReadAll(r)
+	assert.True(t, errors.Is(err, ErrChecksumMismatch))
+}
+
+func TestDownloadCorruptChunks(t *testing.T) {
+	server, _ := newDownloadTestServer()
+	server.corruptChunks = true
+	client := newUploadTestClient(t, server)
+	r, _, err := client.Download(context.Background(), "file-1")
+	assert.Nil(t, err)
+	defer r.Close()
+	_, err = io.ReadAll(r)
+	assert.True(t, errors.Is(err, ErrChecksumMismatch))
+	var chunkErr *ChunkError
+	assert.ErrorAs(t, err, &chunkErr)
+	assert.Equal(t, &ChunkError{FileId: "file-1"}, chunkErr)
+
+	server.corruptChunks, server.misplacedChunks = false, true
+	r, _, err = client.Download(context.Background(), "file-1")
+	assert.Nil(t, err)
+	defer r.Close()
+	_, err = io.ReadAll(r)
+	assert.False(t, errors.Is(err, ErrChecksumMismatch))
+	assert.ErrorAs(t, err, &chunkErr)
+	assert.Equal(t, &ChunkError{FileId: "file-1", Offset: 1}, chunkErr)
+}
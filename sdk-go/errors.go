@@ -0,0 +1,153 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Errors returned by calls to the server match these with errors.Is,
+// according to their status code. errors.As with a *StatusError gives access
+// to the status itself.
+var (
+	ErrNotFound        = errors.New("not found")
+	ErrAlreadyExists   = errors.New("already exists")
+	ErrInvalidArgument = errors.New("invalid argument")
+	ErrUnavailable     = errors.New("server unavailable")
+)
+
+// StatusError is a call to the server which failed with a gRPC status. It
+// implements GRPCStatus so that status.Code and status.FromError keep
+// working on the errors returned by the SDK.
+type StatusError struct {
+	// Op describes the call which failed, such as "create model".
+	Op     string
+	Status *status.Status
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unable to %v: %v", e.Op, e.Status.Err())
+}
+
+// Code returns the status code of the error.
+func (e *StatusError) Code() codes.Code {
+	return e.Status.Code()
+}
+
+func (e *StatusError) GRPCStatus() *status.Status {
+	return e.Status
+}
+
+func (e *StatusError) Is(target error) bool {
+	switch e.Status.Code() {
+	case codes.NotFound:
+		return target == ErrNotFound
+	case codes.AlreadyExists:
+		return target == ErrAlreadyExists
+	case codes.InvalidArgument:
+		return target == ErrInvalidArgument
+	case codes.Unavailable:
+		return target == ErrUnavailable
+	case codes.DataLoss:
+		// servers reject uploads whose chunks or contents don't match
+		// their checksums with DataLoss
+		return target == ErrChecksumMismatch
+	}
+	return false
+}
+
+// FieldViolations returns the invalid fields of the request reported by the
+// server, if any.
+func (e *StatusError) FieldViolations() []*errdetails.BadRequest_FieldViolation {
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, detail := range e.Status.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			violations = append(violations, badRequest.FieldViolations...)
+		}
+	}
+	return violations
+}
+
+// wrapError turns the gRPC status of a failed call into a StatusError. Other
+// errors, including the ones already wrapped, are returned as is.
+func wrapError(op string, err error) error {
+	if err == nil {
+		return nil
+	}
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return err
+	}
+	s, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	return &StatusError{Op: op, Status: s}
+}
+
+// ErrChecksumMismatch is matched by errors.Is when a transferred file doesn't
+// have the checksum recorded by the server.
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+// ChecksumMismatchError describes a file whose checksum didn't match.
+type ChecksumMismatchError struct {
+	FileId    string
+	Algorithm ChecksumAlgorithm
+	// Expected is the checksum recorded by the server and Actual the one
+	// computed from the transferred file.
+	Expected string
+	Actual   string
+}
+
+func (e *ChecksumMismatchError) Error() string {
+	return fmt.Sprintf("checksum mismatch for file %v: expected %v checksum %v, computed %v",
+		e.FileId, e.Algorithm, e.Expected, e.Actual)
+}
+
+func (e *ChecksumMismatchError) Is(target error) bool {
+	return target == ErrChecksumMismatch
+}
+
+// ChunkError describes a chunk of a transferred file which was corrupt or
+// arrived at another offset than the one the transfer reached. Corrupt
+// chunks match ErrChecksumMismatch.
+type ChunkError struct {
+	FileId string
+	Offset uint64
+	// Expected is the offset the transfer reached, it differs from Offset
+	// for chunks which arrived out of order.
+	Expected uint64
+}
+
+func (e *ChunkError) Error() string {
+	if e.Offset != e.Expected {
+		return fmt.Sprintf("received chunk of file %v at offset %v, expected %v", e.FileId, e.Offset, e.Expected)
+	}
+	return fmt.Sprintf("corrupt chunk of file %v at offset %v", e.FileId, e.Offset)
+}
+
+func (e *ChunkError) Is(target error) bool {
+	return target == ErrChecksumMismatch && e.Offset == e.Expected
+}
+
+// ErrInvalidTransition is matched by errors.Is when an action instance can't
+// move to the status it was reported with.
+var ErrInvalidTransition = errors.New("invalid action status transition")
+
+// ActionTransitionError describes a status an action instance can't move to.
+type ActionTransitionError struct {
+	InstanceId string
+	From       ActionStatus
+	To         ActionStatus
+}
+
+func (e *ActionTransitionError) Error() string {
+	return fmt.Sprintf("action instance %v can't move from %v to %v", e.InstanceId, e.From, e.To)
+}
+
+func (e *ActionTransitionError) Is(target error) bool {
+	return target == ErrInvalidTransition
+}
@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestStatusErrorIs(t *testing.T) {
+	cases := map[codes.Code]error{
+		codes.NotFound:        ErrNotFound,
+		codes.AlreadyExists:   ErrAlreadyExists,
+		codes.InvalidArgument: ErrInvalidArgument,
+		codes.Unavailable:     ErrUnavailable,
+		codes.DataLoss:        ErrChecksumMismatch,
+	}
+	for code, target := range cases {
+		err := wrapError("get experiment", status.Error(code, "failed"))
+		assert.ErrorIs(t, err, target)
+		assert.Equal(t, code, status.Code(err))
+		for _, other := range cases {
+			if other != target {
+				assert.False(t, errors.Is(err, other))
+			}
+		}
+	}
+	err := wrapError("get experiment", status.Error(codes.NotFound, "experiment not found"))
+	assert.Equal(t, "unable to get experiment: rpc error: code = NotFound desc = experiment not found", err.Error())
+	assert.Equal(t, err, wrapError("list experiments", err))
+
+	// other errors are left alone
+	assert.Nil(t, wrapError("get experiment", nil))
+	assert.Equal(t, context.Canceled, wrapError("get experiment", context.Canceled))
+}
+
+type invalidServer struct {
+	proto.UnimplementedModelStoreServer
+}
+
+func (s *invalidServer) CreateModel(ctx context.Context, req *proto.CreateModelRequest) (*proto.CreateModelResponse, error) {
+	st, err := status.New(codes.InvalidArgument, "models need a name").WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: "name", Description: "models need a name"}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return nil, st.Err()
+}
+
+func TestStatusErrorFieldViolations(t *testing.T) {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	proto.RegisterModelStoreServer(s, &invalidServer{})
+	go s.Serve(lis)
+	defer s.Stop()
+	dialer := func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}
+	mb, err := NewModelBoxClient("bufnet", WithDialOptions(grpc.WithContextDialer(dialer)))
+	assert.Nil(t, err)
+	defer mb.Close()
+
+	_, err = mb.CreateModel(context.Background(), "", "owner@email", "langtech", "nlp", "")
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	var statusErr *StatusError
+	assert.True(t, errors.As(err, &statusErr))
+	assert.Equal(t, "create model", statusErr.Op)
+	assert.Equal(t, codes.InvalidArgument, statusErr.Code())
+	violations := statusErr.FieldViolations()
+	assert.Len(t, violations, 1)
+	assert.Equal(t, "name", violations[0].Field)
+}
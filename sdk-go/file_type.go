@@ -0,0 +1,175 @@
+package client
+
+import (
+	"mime"
+	"path/filepath"
+	"strings"
+
+	"github.com/tensorland/modelbox/sdk-go/proto"
+)
+
+// FileType describes the kind of content stored in a file tracked by ModelBox.
+type FileType uint8
+
+const (
+	FileTypeUnknown FileType = iota
+	FileTypeModel
+	FileTypeCheckpoint
+	FileTypeText
+	FileTypeImage
+	FileTypeAudio
+	FileTypeVideo
+)
+
+// Extensions of serialized models and checkpoints written by the frameworks
+// we know about. These don't have a registered MIME type so they are matched
+// before falling back to the system MIME database.
+var extFileTypes = map[string]FileType{
+	".pt":          FileTypeModel,
+	".pth":         FileTypeModel,
+	".onnx":        FileTypeModel,
+	".h5":          FileTypeModel,
+	".hdf5":        FileTypeModel,
+	".keras":       FileTypeModel,
+	".pb":          FileTypeModel,
+	".tflite":      FileTypeModel,
+	".safetensors": FileTypeModel,
+	".ckpt":        FileTypeCheckpoint,
+	".txt":         FileTypeText,
+	".log":         FileTypeText,
+	".md":          FileTypeText,
+	".json":        FileTypeText,
+	".yaml":        FileTypeText,
+	".yml":         FileTypeText,
+	".csv":         FileTypeText,
+	".jpg":         FileTypeImage,
+	".jpeg":        FileTypeImage,
+	".png":         FileTypeImage,
+	".gif":         FileTypeImage,
+	".bmp":         FileTypeImage,
+	".mp3":         FileTypeAudio,
+	".wav":         FileTypeAudio,
+	".flac":        FileTypeAudio,
+	".mp4":         FileTypeVideo,
+	".ogv":         FileTypeVideo,
+	".mov":         FileTypeVideo,
+	".m4v":         FileTypeVideo,
+	".mkv":         FileTypeVideo,
+	".webm":        FileTypeVideo,
+}
+
+// FileTypeFromPath guesses the type of a file from its extension, consulting
+// the system MIME database for extensions ModelBox doesn't know about.
+func FileTypeFromPath(path string) FileType {
+	ext := strings.ToLower(filepath.Ext(path))
+	if ext == "" {
+		return FileTypeUnknown
+	}
+	if t, ok := extFileTypes[ext]; ok {
+		return t
+	}
+	return FileTypeFromMIME(mime.TypeByExtension(ext))
+}
+
+// FileTypeFromMIME maps a MIME type such as "image/png" to a FileType.
+func FileTypeFromMIME(mimeType string) FileType {
+	mediaType, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		return FileTypeUnknown
+	}
+	switch strings.SplitN(mediaType, "/", 2)[0] {
+	case "text":
+		return FileTypeText
+	case "image":
+		return FileTypeImage
+	case "audio":
+		return FileTypeAudio
+	case "video":
+		return FileTypeVideo
+	}
+	return FileTypeUnknown
+}
+
+// FileTypeFromStr parses the name of a file type, as returned by String.
+func FileTypeFromStr(t string) FileType {
+	switch strings.ToLower(t) {
+	case "model":
+		return FileTypeModel
+	case "checkpoint":
+		return FileTypeCheckpoint
+	case "text":
+		return FileTypeText
+	case "image":
+		return FileTypeImage
+	case "audio":
+		return FileTypeAudio
+	case "video":
+		return FileTypeVideo
+	}
+	return FileTypeUnknown
+}
+
+// FileTypeFromProto converts the wire representation of a file type.
+func FileTypeFromProto(t proto.FileType) FileType {
+	switch t {
+	case proto.FileType_MODEL:
+		return FileTypeModel
+	case proto.FileType_CHECKPOINT:
+		return FileTypeCheckpoint
+	case proto.FileType_TEXT:
+		return FileTypeText
+	case proto.FileType_IMAGE:
+		return FileTypeImage
+	case proto.FileType_AUDIO:
+		return FileTypeAudio
+	case proto.FileType_VIDEO:
+		return FileTypeVideo
+	}
+	return FileTypeUnknown
+}
+
+// ToProto converts the file type to its wire representation.
+func (t FileType) ToProto() proto.FileType {
+	switch t {
+	case FileTypeModel:
+		return proto.FileType_MODEL
+	case FileTypeCheckpoint:
+		return proto.FileType_CHECKPOINT
+	case FileTypeText:
+		return proto.FileType_TEXT
+	case FileTypeImage:
+		return proto.FileType_IMAGE
+	case FileTypeAudio:
+		return proto.FileType_AUDIO
+	case FileTypeVideo:
+		return proto.FileType_VIDEO
+	}
+	return proto.FileType_UNDEFINED
+}
+
+func (t FileType) String() string {
+	switch t {
+	case FileTypeModel:
+		return "model"
+	case FileTypeCheckpoint:
+		return "checkpoint"
+	case FileTypeText:
+		return "text"
+	case FileTypeImage:
+		return "image"
+	case FileTypeAudio:
+		return "audio"
+	case FileTypeVideo:
+		return "video"
+	}
+	return "unknown"
+}
+
+func (t FileType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t *FileType) UnmarshalText(text []byte) error {
+	*t = FileTypeFromStr(string(text))
+	return nil
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tensorland/modelbox/sdk-go/proto"
+)
+
+func TestFileTypeFromPath(t *testing.T) {
+	assert.Equal(t, FileTypeModel, FileTypeFromPath("/tmp/resnet50.pth"))
+	assert.Equal(t, FileTypeCheckpoint, FileTypeFromPath("epoch=3.CKPT"))
+	assert.Equal(t, FileTypeText, FileTypeFromPath("notes.txt"))
+	assert.Equal(t, FileTypeImage, FileTypeFromPath("confusion_matrix.png"))
+	assert.Equal(t, FileTypeVideo, FileTypeFromPath("rollout.mp4"))
+	assert.Equal(t, FileTypeUnknown, FileTypeFromPath("weights"))
+	assert.Equal(t, FileTypeUnknown, FileTypeFromPath("archive.unknownext"))
+}
+
+func TestFileTypeFromMIME(t *testing.T) {
+	assert.Equal(t, FileTypeImage, FileTypeFromMIME("image/jpeg"))
+	assert.Equal(t, FileTypeText, FileTypeFromMIME("text/plain; charset=utf-8"))
+	assert.Equal(t, FileTypeUnknown, FileTypeFromMIME("application/octet-stream"))
+	assert.Equal(t, FileTypeUnknown, FileTypeFromMIME(""))
+}
+
+func TestFileTypeProtoRoundTrip(t *testing.T) {
+	for v := range proto.FileType_name {
+		p := proto.FileType(v)
+		assert.Equal(t, p, FileTypeFromProto(p).ToProto())
+	}
+	for _, ft := range []FileType{FileTypeModel, FileTypeCheckpoint, FileTypeText, FileTypeImage, FileTypeAudio, FileTypeVideo} {
+		assert.Equal(t, ft, FileTypeFromStr(ft.String()))
+	}
+}
@@ -0,0 +1,244 @@
+package client
+
+import (
+	"context"
+	"errors"
+)
+
+const (
+	// DEFAULT_PAGE_SIZE is the number of objects iterators fetch per call.
+	DEFAULT_PAGE_SIZE = 100
+)
+
+// ErrIteratorDone is returned by the Next method of iterators after the last
+// object.
+var ErrIteratorDone = errors.New("no more objects in iterator")
+
+// pager fetches the pages of a listing for an iterator.
+type pager struct {
+	ctx context.Context
+	// fetch requests the page of token, buffers its objects in the iterator
+	// and returns the token of the next page.
+	fetch func(ctx context.Context, token string) (string, error)
+	token string
+	done  bool
+}
+
+// next fetches the next page, it returns ErrIteratorDone after the last
+// page. A page which failed to be fetched is fetched again by the next call.
+func (p *pager) next() error {
+	if p.done {
+		return ErrIteratorDone
+	}
+	next, err := p.fetch(p.ctx, p.token)
+	if err != nil {
+		return err
+	}
+	p.token = next
+	p.done = next == ""
+	return nil
+}
+
+// ExperimentIterator lists experiments page by page.
+type ExperimentIterator struct {
+	pages pager
+	items []*Experiment
+}
+
+// Next returns the next experiment, fetching a page once the fetched experiments
+// are exhausted. It returns ErrIteratorDone after the last experiment.
+func (it *ExperimentIterator) Next() (*Experiment, error) {
+	for len(it.items) == 0 {
+		if err := it.pages.next(); err != nil {
+			return nil, err
+		}
+	}
+	e := it.items[0]
+	it.items = it.items[1:]
+	return e, nil
+}
+
+// All returns the remaining experiments.
+func (it *ExperimentIterator) All() ([]*Experiment, error) {
+	all := make([]*Experiment, 0, len(it.items))
+	for {
+		e, err := it.Next()
+		if err == ErrIteratorDone {
+			return all, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, e)
+	}
+}
+
+// ModelIterator lists models page by page.
+type ModelIterator struct {
+	pages pager
+	items []*Model
+}
+
+// Next returns the next model, fetching a page once the fetched models
+// are exhausted. It returns ErrIteratorDone after the last model.
+func (it *ModelIterator) Next() (*Model, error) {
+	for len(it.items) == 0 {
+		if err := it.pages.next(); err != nil {
+			return nil, err
+		}
+	}
+	m := it.items[0]
+	it.items = it.items[1:]
+	return m, nil
+}
+
+// All returns the remaining models.
+func (it *ModelIterator) All() ([]*Model, error) {
+	all := make([]*Model, 0, len(it.items))
+	for {
+		m, err := it.Next()
+		if err == ErrIteratorDone {
+			return all, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, m)
+	}
+}
+
+// ModelVersionIterator lists model versions page by page.
+type ModelVersionIterator struct {
+	pages pager
+	items []*ModelVersion
+}
+
+// Next returns the next model version, fetching a page once the fetched model versions
+// are exhausted. It returns ErrIteratorDone after the last model version.
+func (it *ModelVersionIterator) Next() (*ModelVersion, error) {
+	for len(it.items) == 0 {
+		if err := it.pages.next(); err != nil {
+			return nil, err
+		}
+	}
+	v := it.items[0]
+	it.items = it.items[1:]
+	return v, nil
+}
+
+// All returns the remaining model versions.
+func (it *ModelVersionIterator) All() ([]*ModelVersion, error) {
+	all := make([]*ModelVersion, 0, len(it.items))
+	for {
+		v, err := it.Next()
+		if err == ErrIteratorDone {
+			return all, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, v)
+	}
+}
+
+// ArtifactIterator lists artifacts page by page.
+type ArtifactIterator struct {
+	pages pager
+	items []*Artifact
+}
+
+// Next returns the next artifact, fetching a page once the fetched artifacts
+// are exhausted. It returns ErrIteratorDone after the last artifact.
+func (it *ArtifactIterator) Next() (*Artifact, error) {
+	for len(it.items) == 0 {
+		if err := it.pages.next(); err != nil {
+			return nil, err
+		}
+	}
+	a := it.items[0]
+	it.items = it.items[1:]
+	return a, nil
+}
+
+// All returns the remaining artifacts.
+func (it *ArtifactIterator) All() ([]*Artifact, error) {
+	all := make([]*Artifact, 0, len(it.items))
+	for {
+		a, err := it.Next()
+		if err == ErrIteratorDone {
+			return all, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, a)
+	}
+}
+
+// EventIterator lists events page by page.
+type EventIterator struct {
+	pages pager
+	items []*Event
+}
+
+// Next returns the next event, fetching a page once the fetched events
+// are exhausted. It returns ErrIteratorDone after the last event.
+func (it *EventIterator) Next() (*Event, error) {
+	for len(it.items) == 0 {
+		if err := it.pages.next(); err != nil {
+			return nil, err
+		}
+	}
+	e := it.items[0]
+	it.items = it.items[1:]
+	return e, nil
+}
+
+// All returns the remaining events.
+func (it *EventIterator) All() ([]*Event, error) {
+	all := make([]*Event, 0, len(it.items))
+	for {
+		e, err := it.Next()
+		if err == ErrIteratorDone {
+			return all, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, e)
+	}
+}
+
+// SearchResultIterator lists the results of a search page by page.
+type SearchResultIterator struct {
+	pages pager
+	items []*SearchResult
+}
+
+// Next returns the next result, fetching a page once the fetched results
+// are exhausted. It returns ErrIteratorDone after the last result.
+func (it *SearchResultIterator) Next() (*SearchResult, error) {
+	for len(it.items) == 0 {
+		if err := it.pages.next(); err != nil {
+			return nil, err
+		}
+	}
+	r := it.items[0]
+	it.items = it.items[1:]
+	return r, nil
+}
+
+// All returns the remaining results.
+func (it *SearchResultIterator) All() ([]*SearchResult, error) {
+	all := make([]*SearchResult, 0, len(it.items))
+	for {
+		r, err := it.Next()
+		if err == ErrIteratorDone {
+			return all, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, r)
+	}
+}
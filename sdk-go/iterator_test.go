@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// pagingServer lists experiments page by page, failing the calls listed in
+// failures.
+type pagingServer struct {
+	proto.UnimplementedModelStoreServer
+	experiments int
+	calls       []*proto.ListExperimentsRequest
+	failures    map[int]bool
+}
+
+func (s *pagingServer) ListExperiments(ctx context.Context, req *proto.ListExperimentsRequest) (*proto.ListExperimentsResponse, error) {
+	s.calls = append(s.calls, req)
+	if s.failures[len(s.calls)] {
+		return nil, status.Error(codes.Unavailable, "server restarting")
+	}
+	from, _ := strconv.Atoi(req.PageToken)
+	to := from + int(req.PageSize)
+	if req.PageSize == 0 || to > s.experiments {
+		to = s.experiments
+	}
+	resp := &proto.ListExperimentsResponse{}
+	for i := from; i < to; i++ {
+		resp.Experiments = append(resp.Experiments, &proto.Experiment{Id: fmt.Sprint(i), Namespace: req.Namespace})
+	}
+	if to < s.experiments {
+		resp.NextPageToken = strconv.Itoa(to)
+	}
+	return resp, nil
+}
+
+func newPagingClient(t *testing.T, server proto.ModelStoreServer, opts ...ClientOption) *ModelBoxClient {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	proto.RegisterModelStoreServer(s, server)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+	dialer := func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}
+	mb, err := NewModelBoxClient("bufnet", append(opts, WithDialOptions(grpc.WithContextDialer(dialer)))...)
+	assert.Nil(t, err)
+	t.Cleanup(func() { mb.Close() })
+	return mb
+}
+
+func TestExperimentIterator(t *testing.T) {
+	server := &pagingServer{experiments: 5, failures: map[int]bool{2: true}}
+	mb := newPagingClient(t, server, WithPageSize(2))
+	it := mb.Experiments(context.Background(), "langtech")
+
+	e, err := it.Next()
+	assert.Nil(t, err)
+	assert.Equal(t, "0", e.Id)
+	e, err = it.Next()
+	assert.Nil(t, err)
+	assert.Equal(t, "1", e.Id)
+	// the failed page is fetched again by the next call
+	_, err = it.Next()
+	assert.ErrorIs(t, err, ErrUnavailable)
+	rest, err := it.All()
+	assert.Nil(t, err)
+	assert.Len(t, rest, 3)
+	assert.Equal(t, "4", rest[2].Id)
+	_, err = it.Next()
+	assert.Equal(t, ErrIteratorDone, err)
+
+	assert.Len(t, server.calls, 4)
+	for _, req := range server.calls {
+		assert.Equal(t, uint32(2), req.PageSize)
+	}
+	assert.Equal(t, "2", server.calls[2].PageToken)
+}
+
+func TestListFetchesEveryPage(t *testing.T) {
+	server := &pagingServer{experiments: 250}
+	mb := newPagingClient(t, server)
+	experiments, err := mb.ListExperiments(context.Background(), "langtech")
+	assert.Nil(t, err)
+	assert.Len(t, experiments, 250)
+	assert.Len(t, server.calls, 3)
+
+	// empty listings are empty slices rather than nil
+	server = &pagingServer{}
+	mb = newPagingClient(t, server, WithPageSize(0))
+	experiments, err = mb.ListExperiments(context.Background(), "langtech")
+	assert.Nil(t, err)
+	assert.NotNil(t, experiments)
+	assert.Empty(t, experiments)
+}
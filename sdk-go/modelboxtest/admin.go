@@ -0,0 +1,174 @@
+package modelboxtest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	client "github.com/tensorland/modelbox/sdk-go"
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	gproto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// node is a node registered by an agent.
+type node struct {
+	agentName     string
+	info          *proto.NodeInfo
+	lastHeartbeat time.Time
+}
+
+// actionInstance is an instance of an action queued with AddActionInstance.
+type actionInstance struct {
+	name     string
+	arch     string
+	instance *proto.RunnableAction
+	status   client.ActionStatus
+	updates  []*proto.UpdateActionStatusRequest
+}
+
+// ActionInstance describes an instance of an action to run.
+type ActionInstance struct {
+	ActionId string
+	Command  string
+	Params   map[string]interface{}
+	ObjectId string
+	// Arch is the architecture the instance runs on, instances without an
+	// architecture run on any.
+	Arch string
+}
+
+// AddActionInstance queues an instance of the action named name and returns
+// its id. The instance is returned to agents polling for the action until
+// they report it as running.
+func (s *Server) AddActionInstance(name string, instance *ActionInstance) (string, error) {
+	params, err := structpb.NewStruct(instance.Params)
+	if err != nil {
+		return "", fmt.Errorf("unable to encode params: %v", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := objectId(name, instance.ActionId, fmt.Sprint(len(s.queued)))
+	s.actions[id] = &actionInstance{
+		name: name,
+		arch: instance.Arch,
+		instance: &proto.RunnableAction{
+			Id:       id,
+			ActionId: instance.ActionId,
+			Command:  instance.Command,
+			Params:   params.GetFields(),
+			ObjectId: instance.ObjectId,
+		},
+		status: client.ActionStatusPending,
+	}
+	s.queued = append(s.queued, id)
+	return id, nil
+}
+
+// ActionStatus returns the status of an action instance and the updates
+// reported for it, in order.
+func (s *Server) ActionStatus(instanceId string) (client.ActionStatus, []*proto.UpdateActionStatusRequest) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	a, ok := s.actions[instanceId]
+	if !ok {
+		return client.ActionStatusUnknown, nil
+	}
+	updates := make([]*proto.UpdateActionStatusRequest, 0, len(a.updates))
+	for _, u := range a.updates {
+		updates = append(updates, gproto.Clone(u).(*proto.UpdateActionStatusRequest))
+	}
+	return a.status, updates
+}
+
+// LastHeartbeat returns the time of the last heartbeat of a registered node,
+// the zero time if it never heartbeated.
+func (s *Server) LastHeartbeat(nodeId string) (time.Time, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n, ok := s.nodes[nodeId]
+	if !ok {
+		return time.Time{}, false
+	}
+	return n.lastHeartbeat, true
+}
+
+func (s *Server) RegisterAgent(ctx context.Context, req *proto.RegisterAgentRequest) (*proto.RegisterAgentResponse, error) {
+	if req.AgentName == "" || req.NodeInfo == nil {
+		return nil, status.Error(codes.InvalidArgument, "agents need a name and node info")
+	}
+	id := objectId(req.AgentName, req.NodeInfo.HostName, req.NodeInfo.IpAddr, req.NodeInfo.Arch)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.nodes[id]; !ok {
+		s.nodes[id] = &node{
+			agentName: req.AgentName,
+			info:      gproto.Clone(req.NodeInfo).(*proto.NodeInfo),
+		}
+	}
+	return &proto.RegisterAgentResponse{NodeId: id}, nil
+}
+
+func (s *Server) Heartbeat(ctx context.Context, req *proto.HeartbeatRequest) (*proto.HeartbeatResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n, ok := s.nodes[req.NodeId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "node %v isn't registered", req.NodeId)
+	}
+	n.lastHeartbeat = s.opts.now()
+	if req.At != nil {
+		n.lastHeartbeat = req.At.AsTime()
+	}
+	return &proto.HeartbeatResponse{}, nil
+}
+
+// GetRunnableActionInstances returns the pending instances of an action
+// which run on the requested architecture.
+func (s *Server) GetRunnableActionInstances(ctx context.Context, req *proto.GetRunnableActionInstancesRequest) (*proto.GetRunnableActionInstancesResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resp := &proto.GetRunnableActionInstancesResponse{}
+	for _, id := range s.queued {
+		a := s.actions[id]
+		if a.name != req.ActionName || a.status != client.ActionStatusPending {
+			continue
+		}
+		if a.arch != "" && a.arch != req.Arch {
+			continue
+		}
+		resp.Instances = append(resp.Instances, gproto.Clone(a.instance).(*proto.RunnableAction))
+	}
+	return resp, nil
+}
+
+// UpdateActionStatus records the status of an action instance, rejecting
+// the statuses it can't move to.
+func (s *Server) UpdateActionStatus(ctx context.Context, req *proto.UpdateActionStatusRequest) (*proto.UpdateActionStatusResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	a, ok := s.actions[req.ActionInstanceId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "action instance %v not found", req.ActionInstanceId)
+	}
+	next := client.ActionStatusFromProto(req.Status)
+	if !a.status.CanTransitionTo(next) {
+		return nil, status.Errorf(codes.FailedPrecondition, "action instance %v can't move from %v to %v",
+			req.ActionInstanceId, a.status, next)
+	}
+	if req.Progress > 100 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid progress %v", req.Progress)
+	}
+	a.status = next
+	a.updates = append(a.updates, gproto.Clone(req).(*proto.UpdateActionStatusRequest))
+	return &proto.UpdateActionStatusResponse{}, nil
+}
+
+// GetClusterMembers returns the server as the only member of the cluster.
+func (s *Server) GetClusterMembers(ctx context.Context, req *proto.GetClusterMembersRequest) (*proto.GetClusterMembersResponse, error) {
+	return &proto.GetClusterMembersResponse{
+		Members: []*proto.ClusterMember{{Id: "modelboxtest", HostName: "bufnet", RpcAddr: "bufnet"}},
+	}, nil
+}
@@ -0,0 +1,91 @@
+package modelboxtest
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"github.com/tensorland/modelbox/sdk-go/search"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+// inRange reports whether t lies in r, nil ranges hold every time.
+func inRange(t time.Time, r *proto.TimeRange) bool {
+	if r.GetAfter() != nil && t.Before(r.After.AsTime()) {
+		return false
+	}
+	if r.GetBefore() != nil && !t.Before(r.Before.AsTime()) {
+		return false
+	}
+	return true
+}
+
+// hasMetadata reports whether the metadata of an object holds values. The
+// caller holds mu.
+func (s *Server) hasMetadata(id string, values map[string]string) bool {
+	for k, v := range values {
+		if got, ok := s.metadata[id][k]; !ok || got != v {
+			return false
+		}
+	}
+	return true
+}
+
+// sortKey returns the name, creation and update times of an object.
+type sortKey func(id string) (string, time.Time, time.Time)
+
+// sortIds sorts the ids of objects listed in the order they were created.
+// Objects with equal fields stay in the order they were created, or the
+// reverse one for descending orders.
+func sortIds(ids []string, order *proto.SortOrder, key sortKey) {
+	if order.GetDescending() {
+		for i, j := 0, len(ids)-1; i < j; i, j = i+1, j-1 {
+			ids[i], ids[j] = ids[j], ids[i]
+		}
+	}
+	sort.SliceStable(ids, func(i, j int) bool {
+		a, b := ids[i], ids[j]
+		if order.GetDescending() {
+			a, b = b, a
+		}
+		nameA, createdA, updatedA := key(a)
+		nameB, createdB, updatedB := key(b)
+		switch order.GetField() {
+		case proto.SortField_SORT_FIELD_UPDATED_AT:
+			return updatedA.Before(updatedB)
+		case proto.SortField_SORT_FIELD_NAME:
+			return nameA < nameB
+		}
+		return createdA.Before(createdB)
+	})
+}
+
+// Search evaluates the query against the experiments of the namespace in the
+// order they were created, like the server does.
+func (s *Server) Search(ctx context.Context, req *proto.SearchRequest) (*proto.SearchResponse, error) {
+	q, err := search.Parse(req.Query)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var results []*proto.SearchResult
+	for _, id := range s.experimentIds {
+		e := s.experiments[id]
+		if e.Namespace != req.Namespace {
+			continue
+		}
+		r := &search.Record{Experiment: e, Metadata: s.metadata[id], Metrics: s.metrics[id]}
+		if q.Match(r) {
+			results = append(results, gproto.Clone(q.Result(r)).(*proto.SearchResult))
+		}
+	}
+	from, to, next, err := page(len(results), req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.SearchResponse{Results: results[from:to], NextPageToken: next}, nil
+}
@@ -0,0 +1,282 @@
+// Package modelboxtest provides an in-memory ModelBox server for testing code
+// which uses the Go SDK. The server implements the model store and the admin
+// APIs with the semantics of the real server, serves them over an in-memory
+// listener, and lets tests inject failures into any RPC.
+//
+//	s := modelboxtest.NewServer()
+//	defer s.Close()
+//	mb, err := s.NewClient()
+package modelboxtest
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	client "github.com/tensorland/modelbox/sdk-go"
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	// DEFAULT_DOWNLOAD_CHUNK_SIZE is the size of the chunks files are
+	// downloaded in.
+	DEFAULT_DOWNLOAD_CHUNK_SIZE = 64 << 10
+
+	bufSize = 1 << 20
+)
+
+// Hook is called before every RPC with the name of its method, such as
+// "CreateExperiment". The RPC fails with the error returned by the hook,
+// which should be a gRPC status error, when it isn't nil.
+type Hook func(ctx context.Context, method string) error
+
+type options struct {
+	downloadChunkSize int
+	now               func() time.Time
+}
+
+type Option func(*options)
+
+// WithDownloadChunkSize sets the size of the chunks files are downloaded in.
+func WithDownloadChunkSize(size int) Option {
+	return func(o *options) {
+		o.downloadChunkSize = size
+	}
+}
+
+// WithClock sets the function the server reads the time from, for tests
+// which check timestamps.
+func WithClock(now func() time.Time) Option {
+	return func(o *options) {
+		o.now = now
+	}
+}
+
+// Server is an in-memory ModelBox server. Objects are kept until the server
+// is closed, and are only visible to the clients of the server.
+type Server struct {
+	proto.UnimplementedModelStoreServer
+	proto.UnimplementedModelBoxAdminServer
+
+	opts options
+	lis  *bufconn.Listener
+	grpc *grpc.Server
+
+	mu          sync.Mutex
+	experiments map[string]*proto.Experiment
+	models      map[string]*proto.Model
+	versions    map[string]*modelVersion
+	// objects are listed in the order they were created
+	experimentIds []string
+	modelIds      []string
+	versionIds    []string
+	metadata      map[string]map[string]string
+	metrics       map[string]map[string]*proto.Metrics
+	events        map[string][]*proto.Event
+	// idempotencyKeys holds the keys of the logged metrics and events
+	idempotencyKeys map[string]bool
+	artifacts       map[string]*proto.Artifact
+	artifactIds     []string
+	files           map[string]*storedFile
+	uploads         map[string]*upload
+	changes         []*change
+	// changed is closed and replaced whenever a change is recorded
+	changed chan struct{}
+	nodes   map[string]*node
+	actions map[string]*actionInstance
+	// queued lists the ids of action instances in the order they were added
+	queued []string
+
+	hook     Hook
+	failures map[string][]*failure
+	calls    map[string]int
+}
+
+// NewServer creates a server and starts serving it.
+func NewServer(opts ...Option) *Server {
+	o := options{
+		downloadChunkSize: DEFAULT_DOWNLOAD_CHUNK_SIZE,
+		now:               time.Now,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	s := &Server{
+		opts:            o,
+		lis:             bufconn.Listen(bufSize),
+		experiments:     map[string]*proto.Experiment{},
+		models:          map[string]*proto.Model{},
+		versions:        map[string]*modelVersion{},
+		metadata:        map[string]map[string]string{},
+		metrics:         map[string]map[string]*proto.Metrics{},
+		events:          map[string][]*proto.Event{},
+		idempotencyKeys: map[string]bool{},
+		artifacts:       map[string]*proto.Artifact{},
+		files:           map[string]*storedFile{},
+		uploads:         map[string]*upload{},
+		changed:         make(chan struct{}),
+		nodes:           map[string]*node{},
+		actions:         map[string]*actionInstance{},
+		failures:        map[string][]*failure{},
+		calls:           map[string]int{},
+	}
+	s.grpc = grpc.NewServer(
+		grpc.UnaryInterceptor(s.unaryInterceptor),
+		grpc.StreamInterceptor(s.streamInterceptor),
+	)
+	proto.RegisterModelStoreServer(s.grpc, s)
+	proto.RegisterModelBoxAdminServer(s.grpc, s)
+	go s.grpc.Serve(s.lis)
+	return s
+}
+
+// Close stops the server, closing the streams of its clients.
+func (s *Server) Close() {
+	s.grpc.Stop()
+}
+
+// Dial connects to the server over its in-memory listener.
+func (s *Server) Dial(ctx context.Context, addr string) (net.Conn, error) {
+	return s.lis.DialContext(ctx)
+}
+
+// ClientOptions returns the options connecting SDK clients to the server,
+// followed by opts.
+func (s *Server) ClientOptions(opts ...client.ClientOption) []client.ClientOption {
+	return append([]client.ClientOption{client.WithDialOptions(grpc.WithContextDialer(s.Dial))}, opts...)
+}
+
+// NewClient creates a model store client connected to the server.
+func (s *Server) NewClient(opts ...client.ClientOption) (*client.ModelBoxClient, error) {
+	return client.NewModelBoxClient("bufnet", s.ClientOptions(opts...)...)
+}
+
+// NewAdminClient creates an admin client connected to the server.
+func (s *Server) NewAdminClient(opts ...client.ClientOption) (*client.AdminClient, error) {
+	return client.NewAdminClient("bufnet", s.ClientOptions(opts...)...)
+}
+
+// failure is an error injected into the next calls of a method. Failures of
+// streams happen after the given number of messages were exchanged.
+type failure struct {
+	err      error
+	times    int
+	messages int
+}
+
+// SetHook sets the hook called before every RPC, nil removes it.
+func (s *Server) SetHook(hook Hook) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.hook = hook
+}
+
+// FailNext makes the next call of method fail with err, which should be a
+// gRPC status error.
+func (s *Server) FailNext(method string, err error) {
+	s.FailTimes(method, 1, err)
+}
+
+// FailTimes makes the next n calls of method fail with err.
+func (s *Server) FailTimes(method string, n int, err error) {
+	s.addFailure(method, &failure{err: err, times: n})
+}
+
+// FailStreamAfter breaks the next stream of method with err once messages
+// messages were received by UploadFile or sent by DownloadFile and
+// WatchNamespace, e.g. to test resumed transfers and watches.
+func (s *Server) FailStreamAfter(method string, messages int, err error) {
+	s.addFailure(method, &failure{err: err, times: 1, messages: messages})
+}
+
+func (s *Server) addFailure(method string, f *failure) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[method] = append(s.failures[method], f)
+}
+
+// Calls returns the number of calls of method, including the failed ones.
+func (s *Server) Calls(method string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[method]
+}
+
+// nextFailure counts a call of method and returns the failure injected into
+// it, if any.
+func (s *Server) nextFailure(method string) (*failure, Hook) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls[method]++
+	failures := s.failures[method]
+	if len(failures) == 0 {
+		return nil, s.hook
+	}
+	f := failures[0]
+	if f.times--; f.times <= 0 {
+		s.failures[method] = failures[1:]
+	}
+	return f, s.hook
+}
+
+func methodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+func (s *Server) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	method := methodName(info.FullMethod)
+	f, hook := s.nextFailure(method)
+	if hook != nil {
+		if err := hook(ctx, method); err != nil {
+			return nil, err
+		}
+	}
+	if f != nil {
+		return nil, f.err
+	}
+	return handler(ctx, req)
+}
+
+func (s *Server) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	method := methodName(info.FullMethod)
+	f, hook := s.nextFailure(method)
+	if hook != nil {
+		if err := hook(ss.Context(), method); err != nil {
+			return err
+		}
+	}
+	if f == nil {
+		return handler(srv, ss)
+	}
+	if f.messages == 0 {
+		return f.err
+	}
+	return handler(srv, &failingStream{ServerStream: ss, failure: f})
+}
+
+// failingStream fails once its messages were exchanged.
+type failingStream struct {
+	grpc.ServerStream
+	failure  *failure
+	messages int
+}
+
+func (s *failingStream) SendMsg(m interface{}) error {
+	if s.messages >= s.failure.messages {
+		return s.failure.err
+	}
+	s.messages++
+	return s.ServerStream.SendMsg(m)
+}
+
+func (s *failingStream) RecvMsg(m interface{}) error {
+	if s.messages >= s.failure.messages {
+		return s.failure.err
+	}
+	s.messages++
+	return s.ServerStream.RecvMsg(m)
+}
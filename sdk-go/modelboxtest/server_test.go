@@ -0,0 +1,270 @@
+package modelboxtest
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	client "github.com/tensorland/modelbox/sdk-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newTestServer(t *testing.T, opts ...client.ClientOption) (*Server, *client.ModelBoxClient) {
+	s := NewServer(WithDownloadChunkSize(1024))
+	t.Cleanup(s.Close)
+	mb, err := s.NewClient(opts...)
+	assert.Nil(t, err)
+	t.Cleanup(func() { mb.Close() })
+	return s, mb
+}
+
+func TestCreateIsIdempotent(t *testing.T) {
+	_, mb := newTestServer(t)
+	ctx := context.Background()
+
+	exp, err := mb.CreateExperiment(ctx, "bert", "owner@email", "langtech", "ext-1", "pytorch")
+	assert.Nil(t, err)
+	assert.False(t, exp.Exists)
+	again, err := mb.CreateExperiment(ctx, "bert", "owner@email", "langtech", "ext-1", "pytorch")
+	assert.Nil(t, err)
+	assert.True(t, again.Exists)
+	assert.Equal(t, exp.Id, again.Id)
+	assert.Equal(t, exp.CreatedAt, again.CreatedAt)
+
+	model, err := mb.CreateModel(ctx, "bert-base", "owner@email", "langtech", "nlp", "")
+	assert.Nil(t, err)
+	_, err = mb.CreateModelVersion(ctx, model.Id, "v1", "1", "", "langtech", "pytorch", nil)
+	assert.Nil(t, err)
+	version, err := mb.CreateModelVersion(ctx, model.Id, "v1", "1", "", "langtech", "pytorch", nil)
+	assert.Nil(t, err)
+	assert.True(t, version.Exists)
+	_, err = mb.CreateModelVersion(ctx, "missing", "v1", "1", "", "langtech", "pytorch", nil)
+	assert.ErrorContains(t, err, "model missing not found")
+
+	experiments, err := mb.ListExperiments(ctx, "langtech")
+	assert.Nil(t, err)
+	assert.Len(t, experiments, 1)
+	assert.Equal(t, "ext-1", experiments[0].ExternalId)
+	versions, err := mb.ListModelVersions(ctx, model.Id)
+	assert.Nil(t, err)
+	assert.Len(t, versions, 1)
+}
+
+func TestPaging(t *testing.T) {
+	_, mb := newTestServer(t, client.WithPageSize(2))
+	ctx := context.Background()
+	for _, name := range []string{"bert-base", "bert-large", "gpt2", "t5", "xlnet"} {
+		_, err := mb.CreateModel(ctx, name, "owner@email", "langtech", "nlp", "")
+		assert.Nil(t, err)
+	}
+	models, err := mb.ListModels(ctx, "langtech")
+	assert.Nil(t, err)
+	assert.Len(t, models, 5)
+	assert.Equal(t, "xlnet", models[4].Name)
+
+	it := mb.Models(ctx, "vision")
+	_, err = it.Next()
+	assert.Equal(t, client.ErrIteratorDone, err)
+}
+
+func TestQuery(t *testing.T) {
+	_, mb := newTestServer(t, client.WithPageSize(2))
+	ctx := context.Background()
+	for i, name := range []string{"gpt2", "bert-base", "t5"} {
+		exp, err := mb.CreateExperiment(ctx, name, "owner@email", "langtech", "", "pytorch")
+		assert.Nil(t, err)
+		assert.Nil(t, mb.UpdateMetadata(ctx, exp.Id, map[string]interface{}{"tuned": i > 0}))
+	}
+	_, err := mb.CreateExperiment(ctx, "xlnet", "other@email", "langtech", "", "keras")
+	assert.Nil(t, err)
+
+	experiments, err := mb.QueryExperiments("langtech").Owner("owner@email").OrderBy(client.SortByName).All(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, experiments, 3)
+	assert.Equal(t, "bert-base", experiments[0].Name)
+	assert.Equal(t, "t5", experiments[2].Name)
+	experiments, err = mb.QueryExperiments("langtech").Metadata("tuned", true).OrderByDesc(client.SortByCreatedAt).All(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, experiments, 2)
+	assert.Equal(t, "t5", experiments[0].Name)
+	experiments, err = mb.QueryExperiments("langtech").Framework("keras").All(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, experiments, 1)
+	assert.Equal(t, "xlnet", experiments[0].Name)
+}
+
+func TestSearch(t *testing.T) {
+	_, mb := newTestServer(t, client.WithPageSize(1))
+	ctx := context.Background()
+	for i, name := range []string{"gpt2", "bert-base", "t5"} {
+		exp, err := mb.CreateExperiment(ctx, name, "owner@email", "langtech", "", "pytorch")
+		assert.Nil(t, err)
+		assert.Nil(t, mb.UpdateMetadata(ctx, exp.Id, map[string]interface{}{"lr": 0.1 / float64(i+1)}))
+		for step, acc := range []float32{0.5, []float32{0.9, 0.92, 0.94}[i], 0.8} {
+			assert.Nil(t, mb.LogMetrics(ctx, exp.Id, "val/acc", &client.MetricValue{Step: uint64(step), Value: acc}))
+		}
+	}
+
+	results, err := mb.Search(ctx, "langtech", "max(metrics.val/acc) > 0.91 and metadata.lr < 0.1")
+	assert.Nil(t, err)
+	assert.Len(t, results, 2)
+	assert.Equal(t, "bert-base", results[0].Experiment.Name)
+	assert.Equal(t, 0.05, results[0].Metadata["lr"])
+	assert.Equal(t, &client.MetricSummary{Latest: 0.8, Step: 2, Min: 0.5, Max: 0.92}, results[0].Metrics["val/acc"])
+	assert.Equal(t, "t5", results[1].Experiment.Name)
+
+	_, err = mb.Search(ctx, "langtech", "max(metrics.val/acc) >")
+	assert.NotNil(t, err)
+}
+
+func TestMetadataAndMetrics(t *testing.T) {
+	_, mb := newTestServer(t)
+	ctx := context.Background()
+
+	assert.Nil(t, mb.UpdateMetadata(ctx, "exp-1", map[string]interface{}{"lr": 0.1, "layers": 2.0}))
+	assert.Nil(t, mb.UpdateMetadata(ctx, "exp-1", map[string]interface{}{"lr": 0.01}))
+	meta, err := mb.ListMetadata(ctx, "exp-1")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"lr": 0.01, "layers": 2.0}, meta)
+
+	for step := uint64(1); step <= 3; step++ {
+		assert.Nil(t, mb.LogMetrics(ctx, "exp-1", "loss", &client.MetricValue{Step: step, Value: float32(step)}))
+	}
+	metrics, err := mb.GetMetrics(ctx, "exp-1")
+	assert.Nil(t, err)
+	assert.Len(t, metrics["loss"], 3)
+	assert.Equal(t, uint64(3), metrics["loss"][2].Step)
+}
+
+func TestUploadResumesAfterFailure(t *testing.T) {
+	s, mb := newTestServer(t, client.WithUploadChunkSize(1024))
+	ctx := context.Background()
+	data := bytes.Repeat([]byte("modelbox"), 1024)
+	path := filepath.Join(t.TempDir(), "model.pt")
+	assert.Nil(t, os.WriteFile(path, data, 0644))
+	// the metadata and 3 chunks are received before the stream breaks
+	s.FailStreamAfter("UploadFile", 4, status.Error(codes.Unavailable, "connection reset"))
+
+	resp, err := mb.UploadFile(ctx, "weights", "exp-1", path, client.FileTypeModel)
+	assert.Nil(t, err)
+	// the broken stream, the offset query and the resumed stream
+	assert.Equal(t, 3, s.Calls("UploadFile"))
+	stored, ok := s.File(resp.Id)
+	assert.True(t, ok)
+	assert.Equal(t, data, stored)
+
+	artifacts, err := mb.ListArtifacts(ctx, "exp-1")
+	assert.Nil(t, err)
+	assert.Len(t, artifacts, 1)
+	assert.Equal(t, resp.ArtifactId, artifacts[0].Id)
+	assert.Equal(t, resp.Checksum, artifacts[0].Files[0].Checksum)
+
+	r, meta, err := mb.Download(ctx, resp.Id)
+	assert.Nil(t, err)
+	defer r.Close()
+	assert.Equal(t, uint64(len(data)), meta.Size)
+	downloaded := new(bytes.Buffer)
+	_, err = downloaded.ReadFrom(r)
+	assert.Nil(t, err)
+	assert.Equal(t, data, downloaded.Bytes())
+}
+
+func TestUploadInParts(t *testing.T) {
+	s, mb := newTestServer(t, client.WithUploadChunkSize(1024), client.WithParallelTransfers(3, 4*1024))
+	data := bytes.Repeat([]byte("modelbox"), 18*128)
+	path := filepath.Join(t.TempDir(), "model.pt")
+	assert.Nil(t, os.WriteFile(path, data, 0644))
+
+	resp, err := mb.UploadFile(context.Background(), "weights", "exp-1", path, client.FileTypeModel)
+	assert.Nil(t, err)
+	stored, ok := s.File(resp.Id)
+	assert.True(t, ok)
+	assert.Equal(t, data, stored)
+
+	downloaded := filepath.Join(t.TempDir(), "downloaded.pt")
+	_, err = mb.DownloadBlob(context.Background(), resp.Id, downloaded)
+	assert.Nil(t, err)
+	b, err := os.ReadFile(downloaded)
+	assert.Nil(t, err)
+	assert.Equal(t, data, b)
+}
+
+func TestFailNext(t *testing.T) {
+	s, mb := newTestServer(t)
+	ctx := context.Background()
+	s.FailNext("ListModels", status.Error(codes.Unavailable, "unavailable"))
+
+	_, err := mb.ListModels(ctx, "langtech")
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	_, err = mb.ListModels(ctx, "langtech")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, s.Calls("ListModels"))
+
+	s.SetHook(func(ctx context.Context, method string) error {
+		if method == "ListExperiments" {
+			return status.Error(codes.PermissionDenied, "denied")
+		}
+		return nil
+	})
+	_, err = mb.ListExperiments(ctx, "langtech")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestWatch(t *testing.T) {
+	_, mb := newTestServer(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	exp, err := mb.CreateExperiment(ctx, "bert", "owner@email", "langtech", "", "pytorch")
+	assert.Nil(t, err)
+
+	w := mb.Watch(ctx, "langtech", client.WatchSince(time.Unix(0, 0)),
+		client.WatchObjectKinds(client.ObjectKindExperiment, client.ObjectKindMetadata))
+	defer w.Close()
+	event := <-w.Events()
+	assert.Equal(t, client.ObjectKindExperiment, event.Kind)
+	assert.Equal(t, exp.Id, event.Object.(*client.Experiment).Id)
+
+	// models are filtered out
+	_, err = mb.CreateModel(ctx, "bert-base", "owner@email", "langtech", "nlp", "")
+	assert.Nil(t, err)
+	assert.Nil(t, mb.UpdateMetadata(ctx, exp.Id, map[string]interface{}{"lr": 0.1}))
+	event = <-w.Events()
+	assert.Equal(t, client.ObjectKindMetadata, event.Kind)
+	assert.Equal(t, client.ChangeEventObjectUpdated, event.Type)
+	assert.Equal(t, map[string]interface{}{"lr": 0.1}, event.Object.(*client.MetadataChange).Metadata)
+	assert.Equal(t, uint64(3), event.Position)
+}
+
+func TestActionInstances(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	admin, err := s.NewAdminClient()
+	assert.Nil(t, err)
+	defer admin.Close()
+	ctx := context.Background()
+
+	id, err := s.AddActionInstance("eval", &ActionInstance{Command: "eval.sh", Params: map[string]interface{}{"k": 1.0}})
+	assert.Nil(t, err)
+	actions, err := admin.GetRunnableActions(ctx, "eval", "x86_64")
+	assert.Nil(t, err)
+	assert.Len(t, actions, 1)
+	assert.Equal(t, map[string]interface{}{"k": 1.0}, actions[0].Params)
+
+	err = admin.UpdateActionStatus(ctx, id, &client.ActionStatusUpdate{Status: client.ActionStatusSucceeded})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	reporter := admin.NewActionReporter(id, client.ActionStatusPending)
+	assert.Nil(t, reporter.Start(ctx))
+	actions, err = admin.GetRunnableActions(ctx, "eval", "x86_64")
+	assert.Nil(t, err)
+	assert.Empty(t, actions)
+	assert.Nil(t, reporter.Succeed(ctx))
+
+	status, updates := s.ActionStatus(id)
+	assert.Equal(t, client.ActionStatusSucceeded, status)
+	assert.Len(t, updates, 2)
+}
@@ -0,0 +1,444 @@
+package modelboxtest
+
+import (
+	"context"
+	"hash/fnv"
+	"strconv"
+	"time"
+
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	gproto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// modelVersion is a model version along with the namespace it was created
+// in, which isn't part of its message.
+type modelVersion struct {
+	*proto.ModelVersion
+	namespace string
+}
+
+// objectId derives the id of an object from the fields identifying it, so
+// that creating the same object twice returns the same id as the server
+// does.
+func objectId(fields ...string) string {
+	h := fnv.New64a()
+	for _, f := range fields {
+		h.Write([]byte(f))
+		// separates fields so that ("ab", "c") and ("a", "bc") differ
+		h.Write([]byte{0})
+	}
+	return strconv.FormatUint(h.Sum64(), 10)
+}
+
+func (s *Server) now() *timestamppb.Timestamp {
+	return timestamppb.New(s.opts.now())
+}
+
+func (s *Server) CreateExperiment(ctx context.Context, req *proto.CreateExperimentRequest) (*proto.CreateExperimentResponse, error) {
+	if req.Name == "" || req.Namespace == "" {
+		return nil, status.Error(codes.InvalidArgument, "experiments need a name and a namespace")
+	}
+	id := objectId(req.Name, req.Owner, req.Namespace)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e, ok := s.experiments[id]; ok {
+		return &proto.CreateExperimentResponse{
+			ExperimentId:     id,
+			ExperimentExists: true,
+			CreatedAt:        e.CreatedAt,
+			UpdatedAt:        e.UpdatedAt,
+		}, nil
+	}
+	now := s.now()
+	e := &proto.Experiment{
+		Id:         id,
+		Name:       req.Name,
+		Namespace:  req.Namespace,
+		Owner:      req.Owner,
+		Framework:  req.Framework,
+		ExternalId: req.ExternalId,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	s.experiments[id] = e
+	s.experimentIds = append(s.experimentIds, id)
+	s.recordExperiment(proto.ChangeEvent_OBJECT_CREATED, e)
+	return &proto.CreateExperimentResponse{ExperimentId: id, CreatedAt: now, UpdatedAt: now}, nil
+}
+
+func (s *Server) ListExperiments(ctx context.Context, req *proto.ListExperimentsRequest) (*proto.ListExperimentsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ids []string
+	for _, id := range s.experimentIds {
+		e := s.experiments[id]
+		switch {
+		case e.Namespace != req.Namespace:
+		case req.Owner != "" && e.Owner != req.Owner:
+		case req.Framework != proto.MLFramework_UNKNOWN && e.Framework != req.Framework:
+		case req.ExternalId != "" && e.ExternalId != req.ExternalId:
+		case !inRange(e.CreatedAt.AsTime(), req.Created) || !inRange(e.UpdatedAt.AsTime(), req.Updated):
+		case !s.hasMetadata(id, req.Metadata):
+		default:
+			ids = append(ids, id)
+		}
+	}
+	sortIds(ids, req.Sort, func(id string) (string, time.Time, time.Time) {
+		e := s.experiments[id]
+		return e.Name, e.CreatedAt.AsTime(), e.UpdatedAt.AsTime()
+	})
+	from, to, next, err := page(len(ids), req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.ListExperimentsResponse{NextPageToken: next}
+	for _, id := range ids[from:to] {
+		resp.Experiments = append(resp.Experiments, gproto.Clone(s.experiments[id]).(*proto.Experiment))
+	}
+	return resp, nil
+}
+
+func (s *Server) GetExperiment(ctx context.Context, req *proto.GetExperimentRequest) (*proto.GetExperimentResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.experiments[req.Id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Experiment not found")
+	}
+	return &proto.GetExperimentResponse{Experiment: gproto.Clone(e).(*proto.Experiment)}, nil
+}
+
+func (s *Server) CreateModel(ctx context.Context, req *proto.CreateModelRequest) (*proto.CreateModelResponse, error) {
+	if req.Name == "" || req.Namespace == "" {
+		return nil, status.Error(codes.InvalidArgument, "models need a name and a namespace")
+	}
+	id := objectId(req.Name, req.Namespace)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if m, ok := s.models[id]; ok {
+		return &proto.CreateModelResponse{Id: id, Exists: true, CreatedAt: m.CreatedAt, UpdatedAt: m.UpdatedAt}, nil
+	}
+	now := s.now()
+	m := &proto.Model{
+		Id:          id,
+		Name:        req.Name,
+		Owner:       req.Owner,
+		Namespace:   req.Namespace,
+		Description: req.Description,
+		Task:        req.Task,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	s.models[id] = m
+	s.modelIds = append(s.modelIds, id)
+	s.recordModel(proto.ChangeEvent_OBJECT_CREATED, m)
+	return &proto.CreateModelResponse{Id: id, CreatedAt: now, UpdatedAt: now}, nil
+}
+
+func (s *Server) ListModels(ctx context.Context, req *proto.ListModelsRequest) (*proto.ListModelsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ids []string
+	for _, id := range s.modelIds {
+		m := s.models[id]
+		switch {
+		case m.Namespace != req.Namespace:
+		case req.Owner != "" && m.Owner != req.Owner:
+		case req.Task != "" && m.Task != req.Task:
+		case !inRange(m.CreatedAt.AsTime(), req.Created) || !inRange(m.UpdatedAt.AsTime(), req.Updated):
+		case !s.hasMetadata(id, req.Metadata):
+		default:
+			ids = append(ids, id)
+		}
+	}
+	sortIds(ids, req.Sort, func(id string) (string, time.Time, time.Time) {
+		m := s.models[id]
+		return m.Name, m.CreatedAt.AsTime(), m.UpdatedAt.AsTime()
+	})
+	from, to, next, err := page(len(ids), req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.ListModelsResponse{NextPageToken: next}
+	for _, id := range ids[from:to] {
+		resp.Models = append(resp.Models, gproto.Clone(s.models[id]).(*proto.Model))
+	}
+	return resp, nil
+}
+
+func (s *Server) CreateModelVersion(ctx context.Context, req *proto.CreateModelVersionRequest) (*proto.CreateModelVersionResponse, error) {
+	if req.Version == "" {
+		return nil, status.Error(codes.InvalidArgument, "model versions need a version")
+	}
+	id := objectId(req.Model, req.Version)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	model, ok := s.models[req.Model]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "model %v not found", req.Model)
+	}
+	if v, ok := s.versions[id]; ok {
+		return &proto.CreateModelVersionResponse{ModelVersion: id, Exists: true, CreatedAt: v.CreatedAt, UpdatedAt: v.UpdatedAt}, nil
+	}
+	namespace := req.Namespace
+	if namespace == "" {
+		namespace = model.Namespace
+	}
+	now := s.now()
+	v := &modelVersion{
+		ModelVersion: &proto.ModelVersion{
+			Id:          id,
+			ModelId:     req.Model,
+			Name:        req.Name,
+			Version:     req.Version,
+			Description: req.Description,
+			Framework:   req.Framework,
+			UniqueTags:  req.UniqueTags,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		},
+		namespace: namespace,
+	}
+	s.versions[id] = v
+	s.versionIds = append(s.versionIds, id)
+	s.recordModelVersion(proto.ChangeEvent_OBJECT_CREATED, v)
+	return &proto.CreateModelVersionResponse{ModelVersion: id, CreatedAt: now, UpdatedAt: now}, nil
+}
+
+func (s *Server) ListModelVersions(ctx context.Context, req *proto.ListModelVersionsRequest) (*proto.ListModelVersionsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ids []string
+	for _, id := range s.versionIds {
+		if s.versions[id].ModelId == req.Model {
+			ids = append(ids, id)
+		}
+	}
+	from, to, next, err := page(len(ids), req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.ListModelVersionsResponse{NextPageToken: next}
+	for _, id := range ids[from:to] {
+		resp.ModelVersions = append(resp.ModelVersions, gproto.Clone(s.versions[id].ModelVersion).(*proto.ModelVersion))
+	}
+	return resp, nil
+}
+
+// UpdateMetadata merges the keys of the request into the metadata of the
+// object, overwriting the values of existing keys.
+func (s *Server) UpdateMetadata(ctx context.Context, req *proto.UpdateMetadataRequest) (*proto.UpdateMetadataResponse, error) {
+	if req.ParentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "metadata needs a parent id")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	meta, ok := s.metadata[req.ParentId]
+	if !ok {
+		meta = map[string]string{}
+		s.metadata[req.ParentId] = meta
+	}
+	for k, v := range req.GetMetadata().GetMetadata() {
+		meta[k] = v
+	}
+	s.recordMetadata(req.ParentId, meta)
+	return &proto.UpdateMetadataResponse{}, nil
+}
+
+func (s *Server) ListMetadata(ctx context.Context, req *proto.ListMetadataRequest) (*proto.ListMetadataResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	meta := make(map[string]string, len(s.metadata[req.ParentId]))
+	for k, v := range s.metadata[req.ParentId] {
+		meta[k] = v
+	}
+	return &proto.ListMetadataResponse{Metadata: &proto.Metadata{Metadata: meta}}, nil
+}
+
+// firstAttempt records the idempotency key of a call logging a kind of
+// value to an object and reports whether the key is new, calls without a key
+// always are. The caller holds mu.
+func (s *Server) firstAttempt(kind, parentId, key string) bool {
+	if key == "" {
+		return true
+	}
+	key = kind + "/" + parentId + "/" + key
+	if s.idempotencyKeys[key] {
+		return false
+	}
+	s.idempotencyKeys[key] = true
+	return true
+}
+
+// LogMetrics appends a value to the metric of the object, values are
+// returned in the order they were logged. Values logged again with the same
+// idempotency key are only recorded once.
+func (s *Server) LogMetrics(ctx context.Context, req *proto.LogMetricsRequest) (*proto.LogMetricsResponse, error) {
+	if req.ParentId == "" || req.Key == "" || req.Value == nil {
+		return nil, status.Error(codes.InvalidArgument, "metrics need a parent id, a key and a value")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.firstAttempt("metrics", req.ParentId, req.IdempotencyKey) {
+		return &proto.LogMetricsResponse{}, nil
+	}
+	metrics, ok := s.metrics[req.ParentId]
+	if !ok {
+		metrics = map[string]*proto.Metrics{}
+		s.metrics[req.ParentId] = metrics
+	}
+	m, ok := metrics[req.Key]
+	if !ok {
+		m = &proto.Metrics{Key: req.Key}
+		metrics[req.Key] = m
+	}
+	m.Values = append(m.Values, gproto.Clone(req.Value).(*proto.MetricsValue))
+	return &proto.LogMetricsResponse{}, nil
+}
+
+func (s *Server) GetMetrics(ctx context.Context, req *proto.GetMetricsRequest) (*proto.GetMetricsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resp := &proto.GetMetricsResponse{Metrics: map[string]*proto.Metrics{}}
+	for k, m := range s.metrics[req.ParentId] {
+		resp.Metrics[k] = gproto.Clone(m).(*proto.Metrics)
+	}
+	return resp, nil
+}
+
+func (s *Server) LogEvent(ctx context.Context, req *proto.LogEventRequest) (*proto.LogEventResponse, error) {
+	if req.ParentId == "" || req.Event == nil {
+		return nil, status.Error(codes.InvalidArgument, "events need a parent id")
+	}
+	event := gproto.Clone(req.Event).(*proto.Event)
+	if event.WallclockTime == nil {
+		event.WallclockTime = s.now()
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.firstAttempt("events", req.ParentId, req.IdempotencyKey) {
+		return &proto.LogEventResponse{CreatedAt: s.now()}, nil
+	}
+	s.events[req.ParentId] = append(s.events[req.ParentId], event)
+	return &proto.LogEventResponse{CreatedAt: s.now()}, nil
+}
+
+// ListEvents returns the events of the object which happened at or after
+// since, if set.
+func (s *Server) ListEvents(ctx context.Context, req *proto.ListEventsRequest) (*proto.ListEventsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var events []*proto.Event
+	for _, event := range s.events[req.ParentId] {
+		if req.Since != nil && event.WallclockTime.AsTime().Before(req.Since.AsTime()) {
+			continue
+		}
+		events = append(events, event)
+	}
+	from, to, next, err := page(len(events), req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.ListEventsResponse{NextPageToken: next}
+	for _, event := range events[from:to] {
+		resp.Events = append(resp.Events, gproto.Clone(event).(*proto.Event))
+	}
+	return resp, nil
+}
+
+// TrackArtifacts records files stored outside of ModelBox as an artifact of
+// an object. Tracking an artifact again adds its files to the artifact.
+func (s *Server) TrackArtifacts(ctx context.Context, req *proto.TrackArtifactsRequest) (*proto.TrackArtifactsResponse, error) {
+	if req.Name == "" || req.ObjectId == "" {
+		return nil, status.Error(codes.InvalidArgument, "artifacts need a name and an object id")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	files := make([]*proto.FileMetadata, 0, len(req.Files))
+	for _, f := range req.Files {
+		f = gproto.Clone(f).(*proto.FileMetadata)
+		if f.ParentId == "" {
+			f.ParentId = req.ObjectId
+		}
+		if f.Id == "" {
+			f.Id = objectId(f.ParentId, f.SrcPath, f.Checksum, f.FileType.String())
+		}
+		files = append(files, f)
+	}
+	a := s.addFiles(req.Name, req.ObjectId, files...)
+	return &proto.TrackArtifactsResponse{Id: a.Id}, nil
+}
+
+// addFiles adds files to an artifact, replacing the files with the same id,
+// and creates the artifact if needed.
+func (s *Server) addFiles(name, objId string, files ...*proto.FileMetadata) *proto.Artifact {
+	id := objectId(objId, name)
+	a, ok := s.artifacts[id]
+	event := proto.ChangeEvent_OBJECT_UPDATED
+	if !ok {
+		a = &proto.Artifact{Id: id, Name: name, ObjectId: objId}
+		s.artifacts[id] = a
+		s.artifactIds = append(s.artifactIds, id)
+		event = proto.ChangeEvent_OBJECT_CREATED
+	}
+	now := s.now()
+next:
+	for _, f := range files {
+		if f.CreatedAt == nil {
+			f.CreatedAt = now
+		}
+		f.UpdatedAt = now
+		for i, existing := range a.Files {
+			if existing.Id == f.Id {
+				f.CreatedAt = existing.CreatedAt
+				a.Files[i] = f
+				continue next
+			}
+		}
+		a.Files = append(a.Files, f)
+	}
+	s.recordArtifact(event, a)
+	return a
+}
+
+func (s *Server) ListArtifacts(ctx context.Context, req *proto.ListArtifactsRequest) (*proto.ListArtifactsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var ids []string
+	for _, id := range s.artifactIds {
+		if s.artifacts[id].ObjectId == req.ObjectId {
+			ids = append(ids, id)
+		}
+	}
+	from, to, next, err := page(len(ids), req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto.ListArtifactsResponse{NextPageToken: next}
+	for _, id := range ids[from:to] {
+		resp.Artifacts = append(resp.Artifacts, gproto.Clone(s.artifacts[id]).(*proto.Artifact))
+	}
+	return resp, nil
+}
+
+// page returns the bounds of the page selected by the paging fields of a
+// request from a listing of n objects, and the token of the next page. The
+// tokens of the fake are offsets into listings.
+func page(n int, size uint32, token string) (int, int, string, error) {
+	from := 0
+	if token != "" {
+		offset, err := strconv.Atoi(token)
+		if err != nil || offset < 0 || offset > n {
+			return 0, 0, "", status.Error(codes.InvalidArgument, "invalid page token")
+		}
+		from = offset
+	}
+	if size == 0 || from+int(size) >= n {
+		return from, n, "", nil
+	}
+	to := from + int(size)
+	return from, to, strconv.Itoa(to), nil
+}
@@ -0,0 +1,274 @@
+package modelboxtest
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"hash/crc32"
+	"io"
+
+	client "github.com/tensorland/modelbox/sdk-go"
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+var crc32c = crc32.MakeTable(crc32.Castagnoli)
+
+// upload is a resumable upload of a file, or of a part of it, which keeps
+// every chunk received until the stream broke.
+type upload struct {
+	data []byte
+	// checksum is the one sent in the commit frame once committed
+	checksum  string
+	committed bool
+	resp      *proto.UploadFileResponse
+}
+
+// storedFile is a file uploaded to the server.
+type storedFile struct {
+	meta *proto.FileMetadata
+	data []byte
+}
+
+// File returns the contents of an uploaded file.
+func (s *Server) File(id string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, ok := s.files[id]
+	if !ok {
+		return nil, false
+	}
+	return append([]byte(nil), f.data...), true
+}
+
+func uploadKey(meta *proto.UploadFileMetadata) string {
+	if meta.PartNumber == 0 {
+		return meta.UploadId
+	}
+	return fmt.Sprintf("%v/%v", meta.UploadId, meta.PartNumber)
+}
+
+func checksum(algorithm proto.ChecksumAlgorithm, data []byte) string {
+	h := client.ChecksumAlgorithmFromProto(algorithm).New()
+	h.Write(data)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// UploadFile stores the chunks of an upload as they are received, so that a
+// broken stream can be resumed from the last chunk. A stream with only the
+// metadata frame returns the offset stored so far. Uploads sent as plain
+// chunks, without an upload id, are stored when the stream closes.
+func (s *Server) UploadFile(stream proto.ModelStore_UploadFileServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	meta := req.GetMetadata()
+	if meta.GetMetadata() == nil {
+		return status.Error(codes.InvalidArgument, "the first frame must be the file metadata")
+	}
+	if meta.ArtifactName == "" || meta.ObjectId == "" {
+		return status.Error(codes.InvalidArgument, "uploads need an artifact name and an object id")
+	}
+	key := uploadKey(meta)
+	s.mu.Lock()
+	u, ok := s.uploads[key]
+	if !ok {
+		u = &upload{}
+		if meta.UploadId != "" {
+			s.uploads[key] = u
+		}
+	}
+	s.mu.Unlock()
+	legacy := false
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		s.mu.Lock()
+		resp, err := s.receive(meta, u, req, &legacy)
+		s.mu.Unlock()
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return stream.SendAndClose(resp)
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if u.committed {
+		return stream.SendAndClose(u.resp)
+	}
+	if legacy {
+		resp := s.storeFile(meta, u.data, checksum(meta.Metadata.ChecksumAlgorithm, u.data))
+		return stream.SendAndClose(resp)
+	}
+	if meta.UploadId == "" {
+		return status.Error(codes.InvalidArgument, "uploads need an upload id or chunks")
+	}
+	return stream.SendAndClose(&proto.UploadFileResponse{
+		UploadId:        meta.UploadId,
+		CommittedOffset: uint64(len(u.data)),
+	})
+}
+
+// receive handles a frame of an upload, returning the response once it is
+// committed. It is called with the lock held.
+func (s *Server) receive(meta *proto.UploadFileMetadata, u *upload, req *proto.UploadFileRequest, legacy *bool) (*proto.UploadFileResponse, error) {
+	if u.committed {
+		return nil, status.Errorf(codes.FailedPrecondition, "upload %v is already committed", meta.UploadId)
+	}
+	switch frame := req.StreamFrame.(type) {
+	case *proto.UploadFileRequest_Chunks:
+		*legacy = true
+		u.data = append(u.data, frame.Chunks...)
+	case *proto.UploadFileRequest_Chunk:
+		chunk := frame.Chunk
+		if chunk.Offset != uint64(len(u.data)) {
+			return nil, status.Errorf(codes.OutOfRange, "chunk offset %v doesn't match committed offset %v",
+				chunk.Offset, len(u.data))
+		}
+		if crc32.Checksum(chunk.Data, crc32c) != chunk.Crc32C {
+			return nil, status.Errorf(codes.DataLoss, "corrupt chunk at offset %v", chunk.Offset)
+		}
+		u.data = append(u.data, chunk.Data...)
+	case *proto.UploadFileRequest_Commit:
+		commit := frame.Commit
+		if commit.Size != uint64(len(u.data)) {
+			return nil, status.Errorf(codes.DataLoss, "received %v bytes, expected %v", len(u.data), commit.Size)
+		}
+		sum := checksum(meta.Metadata.ChecksumAlgorithm, u.data)
+		if sum != commit.Checksum {
+			return nil, status.Errorf(codes.DataLoss, "checksum mismatch, received %v, computed %v", commit.Checksum, sum)
+		}
+		u.committed, u.checksum = true, sum
+		if meta.PartNumber > 0 {
+			// parts are stored as a file by CompleteUpload
+			u.resp = &proto.UploadFileResponse{
+				UploadId:        meta.UploadId,
+				CommittedOffset: uint64(len(u.data)),
+				Completed:       true,
+			}
+		} else {
+			u.resp = s.storeFile(meta, u.data, sum)
+		}
+		return u.resp, nil
+	default:
+		return nil, status.Error(codes.InvalidArgument, "unexpected upload frame")
+	}
+	return nil, nil
+}
+
+// CompleteUpload assembles the parts of a multipart upload into a file.
+func (s *Server) CompleteUpload(ctx context.Context, req *proto.CompleteUploadRequest) (*proto.UploadFileResponse, error) {
+	meta := req.Metadata
+	if meta.GetMetadata() == nil || meta.UploadId == "" {
+		return nil, status.Error(codes.InvalidArgument, "completed uploads need an upload id and the file metadata")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if u, ok := s.uploads[meta.UploadId]; ok && u.committed {
+		return u.resp, nil
+	}
+	var data []byte
+	for _, part := range req.Parts {
+		key := fmt.Sprintf("%v/%v", meta.UploadId, part.PartNumber)
+		u, ok := s.uploads[key]
+		if !ok || !u.committed {
+			return nil, status.Errorf(codes.FailedPrecondition, "part %v isn't committed", part.PartNumber)
+		}
+		if part.Offset != uint64(len(data)) || part.Size != uint64(len(u.data)) || part.Checksum != u.checksum {
+			return nil, status.Errorf(codes.InvalidArgument, "part %v doesn't match the uploaded part", part.PartNumber)
+		}
+		data = append(data, u.data...)
+	}
+	sum := checksum(meta.Metadata.ChecksumAlgorithm, data)
+	if uint64(len(data)) != req.Size || sum != req.Checksum {
+		return nil, status.Errorf(codes.DataLoss, "assembled file of %v bytes with checksum %v, expected %v bytes with checksum %v",
+			len(data), sum, req.Size, req.Checksum)
+	}
+	for _, part := range req.Parts {
+		delete(s.uploads, fmt.Sprintf("%v/%v", meta.UploadId, part.PartNumber))
+	}
+	resp := s.storeFile(meta, data, sum)
+	s.uploads[meta.UploadId] = &upload{data: data, checksum: sum, committed: true, resp: resp}
+	return resp, nil
+}
+
+// storeFile stores an uploaded file as part of its artifact. Uploading a
+// file with the same source path replaces it. It is called with the lock
+// held.
+func (s *Server) storeFile(meta *proto.UploadFileMetadata, data []byte, sum string) *proto.UploadFileResponse {
+	id := objectId(meta.ObjectId, meta.ArtifactName, meta.Metadata.SrcPath)
+	f := &proto.FileMetadata{
+		Id:                id,
+		ParentId:          meta.ObjectId,
+		FileType:          meta.Metadata.FileType,
+		Checksum:          sum,
+		ChecksumAlgorithm: meta.Metadata.ChecksumAlgorithm,
+		SrcPath:           meta.Metadata.SrcPath,
+		UploadPath:        fmt.Sprintf("modelbox/artifacts/%v/%v", meta.ObjectId, id),
+		Size:              uint64(len(data)),
+	}
+	a := s.addFiles(meta.ArtifactName, meta.ObjectId, f)
+	s.files[id] = &storedFile{meta: f, data: data}
+	return &proto.UploadFileResponse{
+		FileId:          id,
+		ArtifactId:      a.Id,
+		UploadId:        meta.UploadId,
+		CommittedOffset: uint64(len(data)),
+		Completed:       true,
+	}
+}
+
+// DownloadFile sends the metadata of a file followed by the requested range
+// of its contents in chunks.
+func (s *Server) DownloadFile(req *proto.DownloadFileRequest, stream proto.ModelStore_DownloadFileServer) error {
+	s.mu.Lock()
+	f, ok := s.files[req.FileId]
+	var meta *proto.FileMetadata
+	if ok {
+		meta = gproto.Clone(f.meta).(*proto.FileMetadata)
+	}
+	s.mu.Unlock()
+	if !ok {
+		return status.Errorf(codes.NotFound, "file %v not found", req.FileId)
+	}
+	size := uint64(len(f.data))
+	if req.Offset > size {
+		return status.Errorf(codes.OutOfRange, "offset %v is past the end of the file", req.Offset)
+	}
+	end := size
+	if req.Length > 0 && req.Offset+req.Length < end {
+		end = req.Offset + req.Length
+	}
+	if err := stream.Send(&proto.DownloadFileResponse{
+		StreamFrame: &proto.DownloadFileResponse_Metadata{Metadata: meta},
+	}); err != nil {
+		return err
+	}
+	chunkSize := uint64(s.opts.downloadChunkSize)
+	for offset := req.Offset; offset < end; offset += chunkSize {
+		data := f.data[offset:end]
+		if uint64(len(data)) > chunkSize {
+			data = data[:chunkSize]
+		}
+		if err := stream.Send(&proto.DownloadFileResponse{
+			StreamFrame: &proto.DownloadFileResponse_Chunk{Chunk: &proto.FileChunk{
+				Offset: offset,
+				Data:   data,
+				Crc32C: crc32.Checksum(data, crc32c),
+			}},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package modelboxtest
+
+import (
+	"encoding/json"
+	"path"
+	"time"
+
+	"github.com/tensorland/modelbox/internal/changelog"
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// change is an entry of the change log served by WatchNamespace. The name,
+// owner and framework of changes to artifacts and metadata are the ones of
+// the object they belong to, except for the name of artifacts.
+type change struct {
+	*proto.WatchNamespaceResponse
+	namespace string
+	at        time.Time
+	name      string
+	owner     string
+	framework proto.MLFramework
+}
+
+func (s *Server) recordExperiment(event proto.ChangeEvent, e *proto.Experiment) {
+	s.record(&change{
+		WatchNamespaceResponse: &proto.WatchNamespaceResponse{
+			Event:      event,
+			ObjectKind: proto.ObjectKind_OBJECT_KIND_EXPERIMENT,
+			ObjectId:   e.Id,
+		},
+		namespace: e.Namespace,
+		name:      e.Name,
+		owner:     e.Owner,
+		framework: e.Framework,
+	}, changelog.NewExperimentPayload(e))
+}
+
+func (s *Server) recordModel(event proto.ChangeEvent, m *proto.Model) {
+	s.record(&change{
+		WatchNamespaceResponse: &proto.WatchNamespaceResponse{
+			Event:      event,
+			ObjectKind: proto.ObjectKind_OBJECT_KIND_MODEL,
+			ObjectId:   m.Id,
+		},
+		namespace: m.Namespace,
+		name:      m.Name,
+		owner:     m.Owner,
+	}, changelog.NewModelPayload(m))
+}
+
+func (s *Server) recordModelVersion(event proto.ChangeEvent, v *modelVersion) {
+	s.record(&change{
+		WatchNamespaceResponse: &proto.WatchNamespaceResponse{
+			Event:      event,
+			ObjectKind: proto.ObjectKind_OBJECT_KIND_MODEL_VERSION,
+			ObjectId:   v.Id,
+		},
+		namespace: v.namespace,
+		name:      v.Name,
+		owner:     s.models[v.ModelId].GetOwner(),
+		framework: v.Framework,
+	}, changelog.NewModelVersionPayload(v.ModelVersion, v.namespace))
+}
+
+func (s *Server) recordArtifact(event proto.ChangeEvent, a *proto.Artifact) {
+	c := s.objectChange(a.ObjectId)
+	if c == nil {
+		return
+	}
+	c.Event = event
+	c.ObjectKind = proto.ObjectKind_OBJECT_KIND_ARTIFACT
+	c.ObjectId = a.Id
+	c.name = a.Name
+	s.record(c, changelog.NewArtifactPayload(a))
+}
+
+func (s *Server) recordMetadata(objId string, meta map[string]string) {
+	c := s.objectChange(objId)
+	if c == nil {
+		return
+	}
+	c.Event = proto.ChangeEvent_OBJECT_UPDATED
+	c.ObjectKind = proto.ObjectKind_OBJECT_KIND_METADATA
+	s.record(c, changelog.NewMetadataPayload(objId, meta))
+}
+
+// objectChange returns a change carrying the namespace, name, owner and
+// framework of an object, or nil when the object doesn't exist and its
+// changes can't be watched.
+func (s *Server) objectChange(objId string) *change {
+	c := &change{WatchNamespaceResponse: &proto.WatchNamespaceResponse{ObjectId: objId}}
+	if e, ok := s.experiments[objId]; ok {
+		c.namespace, c.name, c.owner, c.framework = e.Namespace, e.Name, e.Owner, e.Framework
+	} else if m, ok := s.models[objId]; ok {
+		c.namespace, c.name, c.owner = m.Namespace, m.Name, m.Owner
+	} else if v, ok := s.versions[objId]; ok {
+		c.namespace, c.name, c.framework = v.namespace, v.Name, v.Framework
+		c.owner = s.models[v.ModelId].GetOwner()
+	} else {
+		return nil
+	}
+	return c
+}
+
+// record appends a change to the log and wakes up the watches. It is called
+// with the lock held.
+func (s *Server) record(c *change, payload interface{}) {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		panic(err)
+	}
+	c.Payload = &structpb.Value{}
+	if err := c.Payload.UnmarshalJSON(b); err != nil {
+		panic(err)
+	}
+	c.Position = uint64(len(s.changes) + 1)
+	c.at = s.opts.now()
+	s.changes = append(s.changes, c)
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// matches reports whether a change passes the filters of a watch.
+func (c *change) matches(req *proto.WatchNamespaceRequest) bool {
+	if c.namespace != req.Namespace {
+		return false
+	}
+	e := &changelog.Entry{
+		Time:      c.at,
+		Kind:      c.ObjectKind,
+		Event:     c.Event,
+		Name:      c.name,
+		Owner:     c.owner,
+		Framework: c.framework,
+	}
+	return e.Matches(req)
+}
+
+// WatchNamespace sends the changes of the namespace which happened after
+// the requested position, or since the requested time, and then the new
+// changes as they happen.
+func (s *Server) WatchNamespace(req *proto.WatchNamespaceRequest, stream proto.ModelStore_WatchNamespaceServer) error {
+	if req.Namespace == "" {
+		return status.Error(codes.InvalidArgument, "watches need a namespace")
+	}
+	if req.NameGlob != "" {
+		if _, err := path.Match(req.NameGlob, ""); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid name glob: %v", err)
+		}
+	}
+	position := req.AfterPosition
+	for {
+		s.mu.Lock()
+		var changes []*change
+		if position > uint64(len(s.changes)) {
+			position = uint64(len(s.changes))
+		}
+		for _, c := range s.changes[position:] {
+			if c.matches(req) {
+				changes = append(changes, c)
+			}
+		}
+		position = uint64(len(s.changes))
+		changed := s.changed
+		s.mu.Unlock()
+		for _, c := range changes {
+			if err := stream.Send(c.WatchNamespaceResponse); err != nil {
+				return err
+			}
+		}
+		select {
+		case <-changed:
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
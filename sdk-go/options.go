@@ -0,0 +1,381 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+)
+
+const API_KEY_HEADER = "x-api-key"
+
+type clientOptions struct {
+	tls                bool
+	tlsConfig          *tls.Config
+	caFile             string
+	certFile           string
+	keyFile            string
+	serverName         string
+	insecureSkipVerify bool
+	bearerToken        string
+	apiKey             string
+	insecureTokens     bool
+	perRPCCreds        credentials.PerRPCCredentials
+	userAgent          string
+	keepalive          *keepalive.ClientParameters
+	maxRecvMsgSize     int
+	maxSendMsgSize     int
+	retryPolicy        *RetryPolicy
+	dialOpts           []grpc.DialOption
+	timeout            time.Duration
+	namespace          string
+	owner              string
+	uploadChunkSize    int
+	uploadResumes      int
+	transferWorkers    int
+	partSize           uint64
+	checksumAlgorithm  ChecksumAlgorithm
+	pageSize           int
+}
+
+func (o *clientOptions) namespaceOrDefault(namespace string) string {
+	if namespace == "" {
+		return o.namespace
+	}
+	return namespace
+}
+
+func (o *clientOptions) ownerOrDefault(owner string) string {
+	if owner == "" {
+		return o.owner
+	}
+	return owner
+}
+
+// ClientOption configures how a client connects to ModelBox.
+type ClientOption func(*clientOptions)
+
+// WithTLS connects over TLS, verifying the server against the CA bundle in
+// caFile. The system roots are used when caFile is empty.
+func WithTLS(caFile string) ClientOption {
+	return func(o *clientOptions) {
+		o.tls = true
+		o.caFile = caFile
+	}
+}
+
+// WithTLSConfig connects over TLS using a fully configured tls.Config. It
+// takes precedence over the other TLS options.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(o *clientOptions) {
+		o.tls = true
+		o.tlsConfig = config
+	}
+}
+
+// WithClientCertificate presents the certificate in certFile to the server
+// for mutual TLS. It implies WithTLS.
+func WithClientCertificate(certFile, keyFile string) ClientOption {
+	return func(o *clientOptions) {
+		o.tls = true
+		o.certFile = certFile
+		o.keyFile = keyFile
+	}
+}
+
+// WithServerName overrides the name used to verify the server certificate.
+// It implies WithTLS.
+func WithServerName(name string) ClientOption {
+	return func(o *clientOptions) {
+		o.tls = true
+		o.serverName = name
+	}
+}
+
+// WithInsecureSkipVerify disables verification of the server certificate.
+// Only use this against test deployments. It implies WithTLS.
+func WithInsecureSkipVerify() ClientOption {
+	return func(o *clientOptions) {
+		o.tls = true
+		o.insecureSkipVerify = true
+	}
+}
+
+// WithBearerToken sends token in the authorization header of every call.
+func WithBearerToken(token string) ClientOption {
+	return func(o *clientOptions) {
+		o.bearerToken = token
+	}
+}
+
+// WithAPIKey sends key in the x-api-key header of every call.
+func WithAPIKey(key string) ClientOption {
+	return func(o *clientOptions) {
+		o.apiKey = key
+	}
+}
+
+// WithInsecureTokens allows bearer tokens and API keys to be sent over
+// connections without TLS, such as to a server behind a TLS terminating
+// sidecar on the same host. Clients refuse to send them in plaintext
+// otherwise.
+func WithInsecureTokens() ClientOption {
+	return func(o *clientOptions) {
+		o.insecureTokens = true
+	}
+}
+
+// WithPerRPCCredentials attaches custom credentials to every call.
+func WithPerRPCCredentials(creds credentials.PerRPCCredentials) ClientOption {
+	return func(o *clientOptions) {
+		o.perRPCCreds = creds
+	}
+}
+
+func WithUserAgent(userAgent string) ClientOption {
+	return func(o *clientOptions) {
+		o.userAgent = userAgent
+	}
+}
+
+// WithKeepalive pings the server after interval of inactivity and closes the
+// connection if the ping isn't acknowledged within timeout.
+func WithKeepalive(interval, timeout time.Duration, permitWithoutStream bool) ClientOption {
+	return func(o *clientOptions) {
+		o.keepalive = &keepalive.ClientParameters{
+			Time:                interval,
+			Timeout:             timeout,
+			PermitWithoutStream: permitWithoutStream,
+		}
+	}
+}
+
+// WithMaxMessageSize sets the largest message in bytes the client receives
+// and sends. Zero keeps the gRPC default.
+func WithMaxMessageSize(recv, send int) ClientOption {
+	return func(o *clientOptions) {
+		o.maxRecvMsgSize = recv
+		o.maxSendMsgSize = send
+	}
+}
+
+// WithDefaultTimeout bounds unary calls made with a context which has no
+// deadline. It defaults to DEADLINE.
+func WithDefaultTimeout(timeout time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithDefaultNamespace is used by calls made with an empty namespace.
+func WithDefaultNamespace(namespace string) ClientOption {
+	return func(o *clientOptions) {
+		o.namespace = namespace
+	}
+}
+
+// WithDefaultOwner is used by calls made with an empty owner.
+func WithDefaultOwner(owner string) ClientOption {
+	return func(o *clientOptions) {
+		o.owner = owner
+	}
+}
+
+// WithRetryPolicy retries idempotent unary calls which fail with a retryable
+// status code. Uploads and watches resume on their own.
+func WithRetryPolicy(policy *RetryPolicy) ClientOption {
+	return func(o *clientOptions) {
+		o.retryPolicy = policy
+	}
+}
+
+// WithUploadChunkSize sets the size in bytes of the chunks files are uploaded
+// in. It defaults to DEFAULT_UPLOAD_CHUNK_SIZE.
+func WithUploadChunkSize(size int) ClientOption {
+	return func(o *clientOptions) {
+		if size > 0 {
+			o.uploadChunkSize = size
+		}
+	}
+}
+
+// WithUploadResumes sets how many times an interrupted upload is continued
+// before it fails with an UploadInterruptedError. Zero disables resuming.
+func WithUploadResumes(resumes int) ClientOption {
+	return func(o *clientOptions) {
+		o.uploadResumes = resumes
+	}
+}
+
+// WithParallelTransfers splits files larger than partSize into parts which
+// are uploaded and downloaded by up to workers concurrent streams. Transfers
+// use a single stream by default. Multipart uploads need a server which
+// implements CompleteUpload.
+func WithParallelTransfers(workers int, partSize uint64) ClientOption {
+	return func(o *clientOptions) {
+		o.transferWorkers = workers
+		if partSize > 0 {
+			o.partSize = partSize
+		}
+	}
+}
+
+// WithChecksumAlgorithm sets the digest used to checksum uploaded files. It
+// defaults to DEFAULT_CHECKSUM_ALGORITHM. Downloads are verified with the
+// algorithm recorded for the file.
+func WithChecksumAlgorithm(algorithm ChecksumAlgorithm) ClientOption {
+	return func(o *clientOptions) {
+		o.checksumAlgorithm = algorithm
+	}
+}
+
+// WithPageSize sets how many objects iterators and list calls fetch per
+// call. It defaults to DEFAULT_PAGE_SIZE, zero fetches listings in a single
+// call.
+func WithPageSize(size int) ClientOption {
+	return func(o *clientOptions) {
+		if size >= 0 {
+			o.pageSize = size
+		}
+	}
+}
+
+// WithDialOptions passes options through to grpc.Dial. They are applied after
+// the options derived from the other ClientOptions.
+func WithDialOptions(opts ...grpc.DialOption) ClientOption {
+	return func(o *clientOptions) {
+		o.dialOpts = append(o.dialOpts, opts...)
+	}
+}
+
+func (o *clientOptions) transportCredentials() (credentials.TransportCredentials, error) {
+	if !o.tls {
+		return insecure.NewCredentials(), nil
+	}
+	if o.tlsConfig != nil {
+		return credentials.NewTLS(o.tlsConfig), nil
+	}
+	config := &tls.Config{
+		ServerName:         o.serverName,
+		InsecureSkipVerify: o.insecureSkipVerify,
+	}
+	if o.caFile != "" {
+		pem, err := os.ReadFile(o.caFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read ca file: %v", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in ca file %v", o.caFile)
+		}
+		config.RootCAs = pool
+	}
+	if o.certFile != "" || o.keyFile != "" {
+		cert, err := tls.LoadX509KeyPair(o.certFile, o.keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %v", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(config), nil
+}
+
+func (o *clientOptions) dialOptions() ([]grpc.DialOption, error) {
+	creds, err := o.transportCredentials()
+	if err != nil {
+		return nil, err
+	}
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	if o.bearerToken != "" || o.apiKey != "" {
+		if !o.tls && !o.insecureTokens {
+			return nil, fmt.Errorf("bearer tokens and API keys need TLS, use WithInsecureTokens to send them in plaintext")
+		}
+		opts = append(opts, grpc.WithPerRPCCredentials(&tokenCredentials{
+			bearerToken:    o.bearerToken,
+			apiKey:         o.apiKey,
+			allowPlaintext: o.insecureTokens,
+		}))
+	}
+	if o.perRPCCreds != nil {
+		opts = append(opts, grpc.WithPerRPCCredentials(o.perRPCCreds))
+	}
+	if o.userAgent != "" {
+		opts = append(opts, grpc.WithUserAgent(o.userAgent))
+	}
+	if o.keepalive != nil {
+		opts = append(opts, grpc.WithKeepaliveParams(*o.keepalive))
+	}
+	var callOpts []grpc.CallOption
+	if o.maxRecvMsgSize > 0 {
+		callOpts = append(callOpts, grpc.MaxCallRecvMsgSize(o.maxRecvMsgSize))
+	}
+	if o.maxSendMsgSize > 0 {
+		callOpts = append(callOpts, grpc.MaxCallSendMsgSize(o.maxSendMsgSize))
+	}
+	if len(callOpts) > 0 {
+		opts = append(opts, grpc.WithDefaultCallOptions(callOpts...))
+	}
+	if o.retryPolicy != nil && o.retryPolicy.MaxAttempts > 1 {
+		interceptor, err := o.retryPolicy.unaryInterceptor()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.WithChainUnaryInterceptor(interceptor))
+	}
+	return append(opts, o.dialOpts...), nil
+}
+
+func dial(addr string, opts ...ClientOption) (*grpc.ClientConn, *clientOptions, error) {
+	o := &clientOptions{
+		timeout:           DEADLINE,
+		uploadChunkSize:   DEFAULT_UPLOAD_CHUNK_SIZE,
+		uploadResumes:     DEFAULT_UPLOAD_RESUMES,
+		transferWorkers:   DEFAULT_TRANSFER_WORKERS,
+		partSize:          DEFAULT_PART_SIZE,
+		checksumAlgorithm: DEFAULT_CHECKSUM_ALGORITHM,
+		pageSize:          DEFAULT_PAGE_SIZE,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	dialOpts, err := o.dialOptions()
+	if err != nil {
+		return nil, nil, err
+	}
+	conn, err := grpc.Dial(addr, dialOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, o, nil
+}
+
+// tokenCredentials attaches a bearer token and/or an API key to every call.
+type tokenCredentials struct {
+	bearerToken    string
+	apiKey         string
+	allowPlaintext bool
+}
+
+func (t *tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	md := map[string]string{}
+	if t.bearerToken != "" {
+		md["authorization"] = "Bearer " + t.bearerToken
+	}
+	if t.apiKey != "" {
+		md[API_KEY_HEADER] = t.apiKey
+	}
+	return md, nil
+}
+
+// Tokens are only sent over plaintext connections when the client was
+// created WithInsecureTokens.
+func (t *tokenCredentials) RequireTransportSecurity() bool {
+	return !t.allowPlaintext
+}
@@ -0,0 +1,94 @@
+package client
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenCredentials(t *testing.T) {
+	creds := &tokenCredentials{bearerToken: "token", apiKey: "key"}
+	md, err := creds.GetRequestMetadata(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "Bearer token", md["authorization"])
+	assert.Equal(t, "key", md[API_KEY_HEADER])
+	assert.True(t, creds.RequireTransportSecurity())
+	creds.allowPlaintext = true
+	assert.False(t, creds.RequireTransportSecurity())
+
+	// tokens aren't sent in plaintext unless the client opts in
+	_, err = NewModelBoxClient("localhost:8085", WithBearerToken("token"))
+	assert.NotNil(t, err)
+	mb, err := NewModelBoxClient("localhost:8085", WithAPIKey("key"), WithInsecureTokens())
+	assert.Nil(t, err)
+	mb.Close()
+	mb, err = NewModelBoxClient("localhost:8085", WithBearerToken("token"), WithTLS(""))
+	assert.Nil(t, err)
+	mb.Close()
+}
+
+func TestDialWithMissingCAFile(t *testing.T) {
+	_, err := NewModelBoxClient("localhost:8085", WithTLS("/does/not/exist.pem"))
+	assert.NotNil(t, err)
+}
+
+func TestTLSOptionsImplyTLS(t *testing.T) {
+	for _, opt := range []ClientOption{WithServerName("modelbox.internal"), WithInsecureSkipVerify()} {
+		o := &clientOptions{}
+		opt(o)
+		assert.True(t, o.tls)
+	}
+	// tokens can be sent since the connection uses TLS
+	mb, err := NewModelBoxClient("localhost:8085", WithBearerToken("token"), WithServerName("modelbox.internal"))
+	assert.Nil(t, err)
+	mb.Close()
+}
+
+func TestClientConfigOptions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "client.yaml")
+	assert.Nil(t, os.WriteFile(path, []byte(`
+server_addr: modelbox.internal:443
+tls:
+  enabled: true
+  server_name: modelbox.internal
+auth:
+  bearer_token: secret
+keepalive:
+  time: 30s
+  timeout: 5s
+max_recv_msg_size: 67108864
+`), 0600))
+	config, err := NewClientConfig(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 30*time.Second, config.Keepalive.Time)
+
+	o := &clientOptions{}
+	for _, opt := range config.Options() {
+		opt(o)
+	}
+	assert.True(t, o.tls)
+	assert.Equal(t, "modelbox.internal", o.serverName)
+	assert.Equal(t, "secret", o.bearerToken)
+	assert.Equal(t, 5*time.Second, o.keepalive.Timeout)
+	assert.Equal(t, 67108864, o.maxRecvMsgSize)
+}
+
+func TestRetryPolicyOption(t *testing.T) {
+	policy := &RetryPolicy{
+		MaxAttempts:    4,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		RetryableCodes: []string{"unavailable", "RESOURCE_EXHAUSTED"},
+	}
+	client, err := NewModelBoxClient("localhost:8085", WithRetryPolicy(policy))
+	assert.Nil(t, err)
+	assert.Nil(t, client.Close())
+
+	policy.RetryableCodes = []string{"NOT_A_CODE"}
+	_, err = NewModelBoxClient("localhost:8085", WithRetryPolicy(policy))
+	assert.NotNil(t, err)
+}
@@ -22,6 +22,113 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ActionStatus int32
+
+const (
+	ActionStatus_ACTION_STATUS_UNKNOWN   ActionStatus = 0
+	ActionStatus_ACTION_STATUS_PENDING   ActionStatus = 1
+	ActionStatus_ACTION_STATUS_RUNNING   ActionStatus = 2
+	ActionStatus_ACTION_STATUS_SUCCEEDED ActionStatus = 3
+	ActionStatus_ACTION_STATUS_FAILED    ActionStatus = 4
+	ActionStatus_ACTION_STATUS_CANCELLED ActionStatus = 5
+)
+
+// Enum value maps for ActionStatus.
+var (
+	ActionStatus_name = map[int32]string{
+		0: "ACTION_STATUS_UNKNOWN",
+		1: "ACTION_STATUS_PENDING",
+		2: "ACTION_STATUS_RUNNING",
+		3: "ACTION_STATUS_SUCCEEDED",
+		4: "ACTION_STATUS_FAILED",
+		5: "ACTION_STATUS_CANCELLED",
+	}
+	ActionStatus_value = map[string]int32{
+		"ACTION_STATUS_UNKNOWN":   0,
+		"ACTION_STATUS_PENDING":   1,
+		"ACTION_STATUS_RUNNING":   2,
+		"ACTION_STATUS_SUCCEEDED": 3,
+		"ACTION_STATUS_FAILED":    4,
+		"ACTION_STATUS_CANCELLED": 5,
+	}
+)
+
+func (x ActionStatus) Enum() *ActionStatus {
+	p := new(ActionStatus)
+	*p = x
+	return p
+}
+
+func (x ActionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_admin_proto_enumTypes[0].Descriptor()
+}
+
+func (ActionStatus) Type() protoreflect.EnumType {
+	return &file_admin_proto_enumTypes[0]
+}
+
+func (x ActionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActionStatus.Descriptor instead.
+func (ActionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{0}
+}
+
+type ActionOutcome int32
+
+const (
+	ActionOutcome_ACTION_OUTCOME_UNKNOWN ActionOutcome = 0
+	ActionOutcome_ACTION_OUTCOME_SUCCESS ActionOutcome = 1
+	ActionOutcome_ACTION_OUTCOME_FAILURE ActionOutcome = 2
+)
+
+// Enum value maps for ActionOutcome.
+var (
+	ActionOutcome_name = map[int32]string{
+		0: "ACTION_OUTCOME_UNKNOWN",
+		1: "ACTION_OUTCOME_SUCCESS",
+		2: "ACTION_OUTCOME_FAILURE",
+	}
+	ActionOutcome_value = map[string]int32{
+		"ACTION_OUTCOME_UNKNOWN": 0,
+		"ACTION_OUTCOME_SUCCESS": 1,
+		"ACTION_OUTCOME_FAILURE": 2,
+	}
+)
+
+func (x ActionOutcome) Enum() *ActionOutcome {
+	p := new(ActionOutcome)
+	*p = x
+	return p
+}
+
+func (x ActionOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ActionOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_admin_proto_enumTypes[1].Descriptor()
+}
+
+func (ActionOutcome) Type() protoreflect.EnumType {
+	return &file_admin_proto_enumTypes[1]
+}
+
+func (x ActionOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ActionOutcome.Descriptor instead.
+func (ActionOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{1}
+}
+
 type GetClusterMembersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -571,6 +678,9 @@ type RunnableAction struct {
 	ActionId string                     `protobuf:"bytes,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
 	Command  string                     `protobuf:"bytes,3,opt,name=command,proto3" json:"command,omitempty"`
 	Params   map[string]*structpb.Value `protobuf:"bytes,5,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The experiment, model or model version the action runs on, the
+	// artifacts produced by the action are attached to it.
+	ObjectId string `protobuf:"bytes,6,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
 }
 
 func (x *RunnableAction) Reset() {
@@ -633,6 +743,13 @@ func (x *RunnableAction) GetParams() map[string]*structpb.Value {
 	return nil
 }
 
+func (x *RunnableAction) GetObjectId() string {
+	if x != nil {
+		return x.ObjectId
+	}
+	return ""
+}
+
 type GetRunnableActionInstancesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -685,12 +802,18 @@ type UpdateActionStatusRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ActionInstanceId string `protobuf:"bytes,1,opt,name=action_instance_id,json=actionInstanceId,proto3" json:"action_instance_id,omitempty"`
-	// Make the following uint32 enum
-	Status        uint32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
-	Outcome       uint32 `protobuf:"varint,3,opt,name=outcome,proto3" json:"outcome,omitempty"`
-	OutcomeReason string `protobuf:"bytes,4,opt,name=outcome_reason,json=outcomeReason,proto3" json:"outcome_reason,omitempty"`
-	UdpateTime    uint64 `protobuf:"varint,5,opt,name=udpate_time,json=udpateTime,proto3" json:"udpate_time,omitempty"`
+	ActionInstanceId string        `protobuf:"bytes,1,opt,name=action_instance_id,json=actionInstanceId,proto3" json:"action_instance_id,omitempty"`
+	Status           ActionStatus  `protobuf:"varint,2,opt,name=status,proto3,enum=modelbox.ActionStatus" json:"status,omitempty"`
+	Outcome          ActionOutcome `protobuf:"varint,3,opt,name=outcome,proto3,enum=modelbox.ActionOutcome" json:"outcome,omitempty"`
+	OutcomeReason    string        `protobuf:"bytes,4,opt,name=outcome_reason,json=outcomeReason,proto3" json:"outcome_reason,omitempty"`
+	// Misspelled, kept for servers which predate update_time.
+	//
+	// Deprecated: Do not use.
+	UdpateTime uint64 `protobuf:"varint,5,opt,name=udpate_time,json=udpateTime,proto3" json:"udpate_time,omitempty"`
+	// Seconds since the epoch
+	UpdateTime uint64 `protobuf:"varint,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	// Percentage of the action which is done, reported while it runs
+	Progress uint32 `protobuf:"varint,7,opt,name=progress,proto3" json:"progress,omitempty"`
 }
 
 func (x *UpdateActionStatusRequest) Reset() {
@@ -732,18 +855,18 @@ func (x *UpdateActionStatusRequest) GetActionInstanceId() string {
 	return ""
 }
 
-func (x *UpdateActionStatusRequest) GetStatus() uint32 {
+func (x *UpdateActionStatusRequest) GetStatus() ActionStatus {
 	if x != nil {
 		return x.Status
 	}
-	return 0
+	return ActionStatus_ACTION_STATUS_UNKNOWN
 }
 
-func (x *UpdateActionStatusRequest) GetOutcome() uint32 {
+func (x *UpdateActionStatusRequest) GetOutcome() ActionOutcome {
 	if x != nil {
 		return x.Outcome
 	}
-	return 0
+	return ActionOutcome_ACTION_OUTCOME_UNKNOWN
 }
 
 func (x *UpdateActionStatusRequest) GetOutcomeReason() string {
@@ -753,6 +876,7 @@ func (x *UpdateActionStatusRequest) GetOutcomeReason() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (x *UpdateActionStatusRequest) GetUdpateTime() uint64 {
 	if x != nil {
 		return x.UdpateTime
@@ -760,6 +884,20 @@ func (x *UpdateActionStatusRequest) GetUdpateTime() uint64 {
 	return 0
 }
 
+func (x *UpdateActionStatusRequest) GetUpdateTime() uint64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+func (x *UpdateActionStatusRequest) GetProgress() uint32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
 type UpdateActionStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -856,7 +994,7 @@ var file_admin_proto_rawDesc = []byte{
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
 	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x74,
 	0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x63, 0x68, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x63, 0x68, 0x22, 0xe8, 0x01, 0x0a, 0x0e,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x63, 0x68, 0x22, 0x85, 0x02, 0x0a, 0x0e,
 	0x52, 0x75, 0x6e, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
 	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b,
 	0x0a, 0x09, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
@@ -866,66 +1004,92 @@ var file_admin_proto_rawDesc = []byte{
 	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x62, 0x6f, 0x78,
 	0x2e, 0x52, 0x75, 0x6e, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
 	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x1a, 0x51, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5c, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x09,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x62, 0x6f, 0x78, 0x2e, 0x52, 0x75, 0x6e, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x73, 0x22, 0xc3, 0x01, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63,
-	0x6f, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f,
-	0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x5f, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x75, 0x74, 0x63,
-	0x6f, 0x6d, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x64, 0x70,
-	0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x75, 0x64, 0x70, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xdf, 0x03, 0x0a, 0x0d, 0x4d, 0x6f, 0x64,
-	0x65, 0x6c, 0x42, 0x6f, 0x78, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x50, 0x0a, 0x0d, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x62, 0x6f, 0x78, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41,
-	0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x62, 0x6f, 0x78, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41,
-	0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x09,
-	0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x1a, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x62, 0x6f, 0x78, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x62, 0x6f, 0x78,
-	0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x77, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
-	0x12, 0x2b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x62, 0x6f, 0x78, 0x2e, 0x47, 0x65, 0x74, 0x52,
-	0x75, 0x6e, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x62, 0x6f, 0x78, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x12, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x23, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x62, 0x6f, 0x78, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x6d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64,
+	0x1a, 0x51, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x5c, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x62, 0x6f, 0x78, 0x2e, 0x52, 0x75, 0x6e, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x22, 0xb5, 0x02, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2c, 0x0a, 0x12, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x62, 0x6f, 0x78, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a,
+	0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x62, 0x6f, 0x78, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0b, 0x75, 0x64, 0x70, 0x61, 0x74,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x18, 0x01,
+	0x52, 0x0a, 0x75, 0x64, 0x70, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x55, 0x70, 0x64,
 	0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x62, 0x6f,
-	0x78, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x11,
-	0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x73, 0x12, 0x22, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x62, 0x6f, 0x78, 0x2e, 0x47, 0x65, 0x74,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x62, 0x6f, 0x78,
-	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x6c,
-	0x61, 0x6e, 0x64, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x62, 0x6f, 0x78, 0x2f, 0x73, 0x64, 0x6b,
-	0x2d, 0x67, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0xb3, 0x01, 0x0a, 0x0c, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x19,
+	0x0a, 0x15, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45,
+	0x45, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04,
+	0x12, 0x1b, 0x0a, 0x17, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x63, 0x0a,
+	0x0d, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x16, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45,
+	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x53, 0x55, 0x43,
+	0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45,
+	0x10, 0x02, 0x32, 0xdf, 0x03, 0x0a, 0x0d, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x42, 0x6f, 0x78, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x12, 0x50, 0x0a, 0x0d, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x62, 0x6f, 0x78,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x62, 0x6f, 0x78,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x12, 0x1a, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x62, 0x6f, 0x78, 0x2e, 0x48,
+	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x62, 0x6f, 0x78, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74,
+	0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x62, 0x6f, 0x78, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x62,
+	0x6f, 0x78, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x75, 0x6e, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x62, 0x6f, 0x78, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x62, 0x6f, 0x78, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x22, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x62, 0x6f, 0x78, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x62, 0x6f, 0x78, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x6e, 0x73, 0x6f, 0x72, 0x6c, 0x61, 0x6e, 0x64, 0x2f, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x62, 0x6f, 0x78, 0x2f, 0x73, 0x64, 0x6b, 0x2d, 0x67, 0x6f, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -940,48 +1104,53 @@ func file_admin_proto_rawDescGZIP() []byte {
 	return file_admin_proto_rawDescData
 }
 
+var file_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_admin_proto_goTypes = []interface{}{
-	(*GetClusterMembersRequest)(nil),           // 0: modelbox.GetClusterMembersRequest
-	(*ClusterMember)(nil),                      // 1: modelbox.ClusterMember
-	(*GetClusterMembersResponse)(nil),          // 2: modelbox.GetClusterMembersResponse
-	(*NodeInfo)(nil),                           // 3: modelbox.NodeInfo
-	(*HeartbeatRequest)(nil),                   // 4: modelbox.HeartbeatRequest
-	(*HeartbeatResponse)(nil),                  // 5: modelbox.HeartbeatResponse
-	(*SubscribeEventRequest)(nil),              // 6: modelbox.SubscribeEventRequest
-	(*RegisterAgentRequest)(nil),               // 7: modelbox.RegisterAgentRequest
-	(*RegisterAgentResponse)(nil),              // 8: modelbox.RegisterAgentResponse
-	(*GetRunnableActionInstancesRequest)(nil),  // 9: modelbox.GetRunnableActionInstancesRequest
-	(*RunnableAction)(nil),                     // 10: modelbox.RunnableAction
-	(*GetRunnableActionInstancesResponse)(nil), // 11: modelbox.GetRunnableActionInstancesResponse
-	(*UpdateActionStatusRequest)(nil),          // 12: modelbox.UpdateActionStatusRequest
-	(*UpdateActionStatusResponse)(nil),         // 13: modelbox.UpdateActionStatusResponse
-	nil,                                        // 14: modelbox.RunnableAction.ParamsEntry
-	(*timestamppb.Timestamp)(nil),              // 15: google.protobuf.Timestamp
-	(*structpb.Value)(nil),                     // 16: google.protobuf.Value
+	(ActionStatus)(0),                          // 0: modelbox.ActionStatus
+	(ActionOutcome)(0),                         // 1: modelbox.ActionOutcome
+	(*GetClusterMembersRequest)(nil),           // 2: modelbox.GetClusterMembersRequest
+	(*ClusterMember)(nil),                      // 3: modelbox.ClusterMember
+	(*GetClusterMembersResponse)(nil),          // 4: modelbox.GetClusterMembersResponse
+	(*NodeInfo)(nil),                           // 5: modelbox.NodeInfo
+	(*HeartbeatRequest)(nil),                   // 6: modelbox.HeartbeatRequest
+	(*HeartbeatResponse)(nil),                  // 7: modelbox.HeartbeatResponse
+	(*SubscribeEventRequest)(nil),              // 8: modelbox.SubscribeEventRequest
+	(*RegisterAgentRequest)(nil),               // 9: modelbox.RegisterAgentRequest
+	(*RegisterAgentResponse)(nil),              // 10: modelbox.RegisterAgentResponse
+	(*GetRunnableActionInstancesRequest)(nil),  // 11: modelbox.GetRunnableActionInstancesRequest
+	(*RunnableAction)(nil),                     // 12: modelbox.RunnableAction
+	(*GetRunnableActionInstancesResponse)(nil), // 13: modelbox.GetRunnableActionInstancesResponse
+	(*UpdateActionStatusRequest)(nil),          // 14: modelbox.UpdateActionStatusRequest
+	(*UpdateActionStatusResponse)(nil),         // 15: modelbox.UpdateActionStatusResponse
+	nil,                                        // 16: modelbox.RunnableAction.ParamsEntry
+	(*timestamppb.Timestamp)(nil),              // 17: google.protobuf.Timestamp
+	(*structpb.Value)(nil),                     // 18: google.protobuf.Value
 }
 var file_admin_proto_depIdxs = []int32{
-	1,  // 0: modelbox.GetClusterMembersResponse.members:type_name -> modelbox.ClusterMember
-	15, // 1: modelbox.HeartbeatRequest.at:type_name -> google.protobuf.Timestamp
-	3,  // 2: modelbox.RegisterAgentRequest.node_info:type_name -> modelbox.NodeInfo
-	14, // 3: modelbox.RunnableAction.params:type_name -> modelbox.RunnableAction.ParamsEntry
-	10, // 4: modelbox.GetRunnableActionInstancesResponse.instances:type_name -> modelbox.RunnableAction
-	16, // 5: modelbox.RunnableAction.ParamsEntry.value:type_name -> google.protobuf.Value
-	7,  // 6: modelbox.ModelBoxAdmin.RegisterAgent:input_type -> modelbox.RegisterAgentRequest
-	4,  // 7: modelbox.ModelBoxAdmin.Heartbeat:input_type -> modelbox.HeartbeatRequest
-	9,  // 8: modelbox.ModelBoxAdmin.GetRunnableActionInstances:input_type -> modelbox.GetRunnableActionInstancesRequest
-	12, // 9: modelbox.ModelBoxAdmin.UpdateActionStatus:input_type -> modelbox.UpdateActionStatusRequest
-	0,  // 10: modelbox.ModelBoxAdmin.GetClusterMembers:input_type -> modelbox.GetClusterMembersRequest
-	8,  // 11: modelbox.ModelBoxAdmin.RegisterAgent:output_type -> modelbox.RegisterAgentResponse
-	5,  // 12: modelbox.ModelBoxAdmin.Heartbeat:output_type -> modelbox.HeartbeatResponse
-	11, // 13: modelbox.ModelBoxAdmin.GetRunnableActionInstances:output_type -> modelbox.GetRunnableActionInstancesResponse
-	13, // 14: modelbox.ModelBoxAdmin.UpdateActionStatus:output_type -> modelbox.UpdateActionStatusResponse
-	2,  // 15: modelbox.ModelBoxAdmin.GetClusterMembers:output_type -> modelbox.GetClusterMembersResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	3,  // 0: modelbox.GetClusterMembersResponse.members:type_name -> modelbox.ClusterMember
+	17, // 1: modelbox.HeartbeatRequest.at:type_name -> google.protobuf.Timestamp
+	5,  // 2: modelbox.RegisterAgentRequest.node_info:type_name -> modelbox.NodeInfo
+	16, // 3: modelbox.RunnableAction.params:type_name -> modelbox.RunnableAction.ParamsEntry
+	12, // 4: modelbox.GetRunnableActionInstancesResponse.instances:type_name -> modelbox.RunnableAction
+	0,  // 5: modelbox.UpdateActionStatusRequest.status:type_name -> modelbox.ActionStatus
+	1,  // 6: modelbox.UpdateActionStatusRequest.outcome:type_name -> modelbox.ActionOutcome
+	18, // 7: modelbox.RunnableAction.ParamsEntry.value:type_name -> google.protobuf.Value
+	9,  // 8: modelbox.ModelBoxAdmin.RegisterAgent:input_type -> modelbox.RegisterAgentRequest
+	6,  // 9: modelbox.ModelBoxAdmin.Heartbeat:input_type -> modelbox.HeartbeatRequest
+	11, // 10: modelbox.ModelBoxAdmin.GetRunnableActionInstances:input_type -> modelbox.GetRunnableActionInstancesRequest
+	14, // 11: modelbox.ModelBoxAdmin.UpdateActionStatus:input_type -> modelbox.UpdateActionStatusRequest
+	2,  // 12: modelbox.ModelBoxAdmin.GetClusterMembers:input_type -> modelbox.GetClusterMembersRequest
+	10, // 13: modelbox.ModelBoxAdmin.RegisterAgent:output_type -> modelbox.RegisterAgentResponse
+	7,  // 14: modelbox.ModelBoxAdmin.Heartbeat:output_type -> modelbox.HeartbeatResponse
+	13, // 15: modelbox.ModelBoxAdmin.GetRunnableActionInstances:output_type -> modelbox.GetRunnableActionInstancesResponse
+	15, // 16: modelbox.ModelBoxAdmin.UpdateActionStatus:output_type -> modelbox.UpdateActionStatusResponse
+	4,  // 17: modelbox.ModelBoxAdmin.GetClusterMembers:output_type -> modelbox.GetClusterMembersResponse
+	13, // [13:18] is the sub-list for method output_type
+	8,  // [8:13] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_admin_proto_init() }
@@ -1164,13 +1333,14 @@ func file_admin_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_admin_proto_rawDesc,
-			NumEnums:      0,
+			NumEnums:      2,
 			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_admin_proto_goTypes,
 		DependencyIndexes: file_admin_proto_depIdxs,
+		EnumInfos:         file_admin_proto_enumTypes,
 		MessageInfos:      file_admin_proto_msgTypes,
 	}.Build()
 	File_admin_proto = out.File
@@ -39,6 +39,8 @@ type ModelStoreClient interface {
 	GetExperiment(ctx context.Context, in *GetExperimentRequest, opts ...grpc.CallOption) (*GetExperimentResponse, error)
 	// UploadFile streams a files to ModelBox and stores the binaries to the condfigured storage
 	UploadFile(ctx context.Context, opts ...grpc.CallOption) (ModelStore_UploadFileClient, error)
+	// Assembles the parts of a multipart upload into a single file
+	CompleteUpload(ctx context.Context, in *CompleteUploadRequest, opts ...grpc.CallOption) (*UploadFileResponse, error)
 	// DownloadFile downloads a file from configured storage
 	DownloadFile(ctx context.Context, in *DownloadFileRequest, opts ...grpc.CallOption) (ModelStore_DownloadFileClient, error)
 	// Persists a set of metadata related to objects
@@ -61,6 +63,9 @@ type ModelStoreClient interface {
 	// Streams change events in any of objects such as experiments, models, etc, for a given namespace
 	// Response is a json representation of the new state of the obejct
 	WatchNamespace(ctx context.Context, in *WatchNamespaceRequest, opts ...grpc.CallOption) (ModelStore_WatchNamespaceClient, error)
+	// Search the experiments of a namespace with a query over their fields,
+	// metadata and metrics.
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
 }
 
 type modelStoreClient struct {
@@ -168,6 +173,15 @@ func (x *modelStoreUploadFileClient) CloseAndRecv() (*UploadFileResponse, error)
 	return m, nil
 }
 
+func (c *modelStoreClient) CompleteUpload(ctx context.Context, in *CompleteUploadRequest, opts ...grpc.CallOption) (*UploadFileResponse, error) {
+	out := new(UploadFileResponse)
+	err := c.cc.Invoke(ctx, "/modelbox.ModelStore/CompleteUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *modelStoreClient) DownloadFile(ctx context.Context, in *DownloadFileRequest, opts ...grpc.CallOption) (ModelStore_DownloadFileClient, error) {
 	stream, err := c.cc.NewStream(ctx, &ModelStore_ServiceDesc.Streams[1], "/modelbox.ModelStore/DownloadFile", opts...)
 	if err != nil {
@@ -304,6 +318,15 @@ func (x *modelStoreWatchNamespaceClient) Recv() (*WatchNamespaceResponse, error)
 	return m, nil
 }
 
+func (c *modelStoreClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, "/modelbox.ModelStore/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ModelStoreServer is the server API for ModelStore service.
 // All implementations must embed UnimplementedModelStoreServer
 // for forward compatibility
@@ -325,6 +348,8 @@ type ModelStoreServer interface {
 	GetExperiment(context.Context, *GetExperimentRequest) (*GetExperimentResponse, error)
 	// UploadFile streams a files to ModelBox and stores the binaries to the condfigured storage
 	UploadFile(ModelStore_UploadFileServer) error
+	// Assembles the parts of a multipart upload into a single file
+	CompleteUpload(context.Context, *CompleteUploadRequest) (*UploadFileResponse, error)
 	// DownloadFile downloads a file from configured storage
 	DownloadFile(*DownloadFileRequest, ModelStore_DownloadFileServer) error
 	// Persists a set of metadata related to objects
@@ -347,6 +372,9 @@ type ModelStoreServer interface {
 	// Streams change events in any of objects such as experiments, models, etc, for a given namespace
 	// Response is a json representation of the new state of the obejct
 	WatchNamespace(*WatchNamespaceRequest, ModelStore_WatchNamespaceServer) error
+	// Search the experiments of a namespace with a query over their fields,
+	// metadata and metrics.
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
 	mustEmbedUnimplementedModelStoreServer()
 }
 
@@ -378,6 +406,9 @@ func (UnimplementedModelStoreServer) GetExperiment(context.Context, *GetExperime
 func (UnimplementedModelStoreServer) UploadFile(ModelStore_UploadFileServer) error {
 	return status.Errorf(codes.Unimplemented, "method UploadFile not implemented")
 }
+func (UnimplementedModelStoreServer) CompleteUpload(context.Context, *CompleteUploadRequest) (*UploadFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteUpload not implemented")
+}
 func (UnimplementedModelStoreServer) DownloadFile(*DownloadFileRequest, ModelStore_DownloadFileServer) error {
 	return status.Errorf(codes.Unimplemented, "method DownloadFile not implemented")
 }
@@ -408,6 +439,9 @@ func (UnimplementedModelStoreServer) ListEvents(context.Context, *ListEventsRequ
 func (UnimplementedModelStoreServer) WatchNamespace(*WatchNamespaceRequest, ModelStore_WatchNamespaceServer) error {
 	return status.Errorf(codes.Unimplemented, "method WatchNamespace not implemented")
 }
+func (UnimplementedModelStoreServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
 func (UnimplementedModelStoreServer) mustEmbedUnimplementedModelStoreServer() {}
 
 // UnsafeModelStoreServer may be embedded to opt out of forward compatibility for this service.
@@ -573,6 +607,24 @@ func (x *modelStoreUploadFileServer) Recv() (*UploadFileRequest, error) {
 	return m, nil
 }
 
+func _ModelStore_CompleteUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelStoreServer).CompleteUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modelbox.ModelStore/CompleteUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelStoreServer).CompleteUpload(ctx, req.(*CompleteUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ModelStore_DownloadFile_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(DownloadFileRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -759,6 +811,24 @@ func (x *modelStoreWatchNamespaceServer) Send(m *WatchNamespaceResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ModelStore_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModelStoreServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modelbox.ModelStore/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModelStoreServer).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ModelStore_ServiceDesc is the grpc.ServiceDesc for ModelStore service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -794,6 +864,10 @@ var ModelStore_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetExperiment",
 			Handler:    _ModelStore_GetExperiment_Handler,
 		},
+		{
+			MethodName: "CompleteUpload",
+			Handler:    _ModelStore_CompleteUpload_Handler,
+		},
 		{
 			MethodName: "UpdateMetadata",
 			Handler:    _ModelStore_UpdateMetadata_Handler,
@@ -826,6 +900,10 @@ var ModelStore_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListEvents",
 			Handler:    _ModelStore_ListEvents_Handler,
 		},
+		{
+			MethodName: "Search",
+			Handler:    _ModelStore_Search_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
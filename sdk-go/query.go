@@ -0,0 +1,251 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	gproto "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SortField is a field the server sorts listings by.
+type SortField uint8
+
+const (
+	SortByCreatedAt SortField = iota
+	SortByUpdatedAt
+	SortByName
+)
+
+func (f SortField) toProto() proto.SortField {
+	switch f {
+	case SortByUpdatedAt:
+		return proto.SortField_SORT_FIELD_UPDATED_AT
+	case SortByName:
+		return proto.SortField_SORT_FIELD_NAME
+	}
+	return proto.SortField_SORT_FIELD_CREATED_AT
+}
+
+// timeRange returns the range between after, inclusive, and before,
+// exclusive. Zero times leave their bound open.
+func timeRange(after, before time.Time) *proto.TimeRange {
+	r := &proto.TimeRange{}
+	if !after.IsZero() {
+		r.After = timestamppb.New(after)
+	}
+	if !before.IsZero() {
+		r.Before = timestamppb.New(before)
+	}
+	return r
+}
+
+// encodeQueryMetadata adds a metadata value to the metadata filter of a
+// query.
+func encodeQueryMetadata(metadata map[string]string, key string, value interface{}) (map[string]string, error) {
+	encoded, err := encodeMetadata(map[string]interface{}{key: value})
+	if err != nil {
+		return metadata, err
+	}
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	metadata[key] = encoded[key]
+	return metadata, nil
+}
+
+// ExperimentQuery selects experiments of a namespace, the server returns the
+// experiments which match all of the filters of the query:
+//
+//	experiments, err := mb.QueryExperiments("langtech").
+//		Owner("owner@email").
+//		Framework("pytorch").
+//		OrderByDesc(SortByCreatedAt).
+//		All(ctx)
+type ExperimentQuery struct {
+	m   *ModelBoxClient
+	req *proto.ListExperimentsRequest
+	err error
+}
+
+// QueryExperiments starts a query of the experiments of a namespace, which
+// are sorted by creation time unless the query orders them otherwise.
+func (m *ModelBoxClient) QueryExperiments(namespace string) *ExperimentQuery {
+	return &ExperimentQuery{m: m, req: &proto.ListExperimentsRequest{
+		Namespace: m.opts.namespaceOrDefault(namespace),
+		PageSize:  uint32(m.opts.pageSize),
+	}}
+}
+
+func (q *ExperimentQuery) Owner(owner string) *ExperimentQuery {
+	q.req.Owner = owner
+	return q
+}
+
+func (q *ExperimentQuery) Framework(framework string) *ExperimentQuery {
+	q.req.Framework = MLFrameworkProtoFromStr(framework)
+	return q
+}
+
+func (q *ExperimentQuery) ExternalId(externalId string) *ExperimentQuery {
+	q.req.ExternalId = externalId
+	return q
+}
+
+// CreatedBetween selects experiments created at or after after and before
+// before, zero times leave their bound open.
+func (q *ExperimentQuery) CreatedBetween(after, before time.Time) *ExperimentQuery {
+	q.req.Created = timeRange(after, before)
+	return q
+}
+
+// UpdatedBetween selects experiments updated at or after after and before
+// before, zero times leave their bound open.
+func (q *ExperimentQuery) UpdatedBetween(after, before time.Time) *ExperimentQuery {
+	q.req.Updated = timeRange(after, before)
+	return q
+}
+
+// Metadata selects experiments whose metadata holds value for key, values
+// compare as they are encoded by UpdateMetadata.
+func (q *ExperimentQuery) Metadata(key string, value interface{}) *ExperimentQuery {
+	metadata, err := encodeQueryMetadata(q.req.Metadata, key, value)
+	if err != nil && q.err == nil {
+		q.err = err
+	}
+	q.req.Metadata = metadata
+	return q
+}
+
+func (q *ExperimentQuery) OrderBy(field SortField) *ExperimentQuery {
+	q.req.Sort = &proto.SortOrder{Field: field.toProto()}
+	return q
+}
+
+func (q *ExperimentQuery) OrderByDesc(field SortField) *ExperimentQuery {
+	q.req.Sort = &proto.SortOrder{Field: field.toProto(), Descending: true}
+	return q
+}
+
+// Iterator iterates over the experiments selected by the query.
+func (q *ExperimentQuery) Iterator(ctx context.Context) *ExperimentIterator {
+	it := &ExperimentIterator{}
+	req := gproto.Clone(q.req).(*proto.ListExperimentsRequest)
+	queryErr := q.err
+	m := q.m
+	it.pages = pager{ctx: ctx, fetch: func(ctx context.Context, token string) (string, error) {
+		if queryErr != nil {
+			return "", queryErr
+		}
+		ctx, cancel := withDefaultDeadline(ctx, m.opts.timeout)
+		defer cancel()
+		req.PageToken = token
+		resp, err := m.client.ListExperiments(ctx, req)
+		if err != nil {
+			return "", wrapError("list experiments", err)
+		}
+		for _, e := range resp.Experiments {
+			it.items = append(it.items, experimentFromProto(e))
+		}
+		return resp.NextPageToken, nil
+	}}
+	return it
+}
+
+// All returns every experiment selected by the query.
+func (q *ExperimentQuery) All(ctx context.Context) ([]*Experiment, error) {
+	return q.Iterator(ctx).All()
+}
+
+// ModelQuery selects models of a namespace, the server returns the models
+// which match all of the filters of the query.
+type ModelQuery struct {
+	m   *ModelBoxClient
+	req *proto.ListModelsRequest
+	err error
+}
+
+// QueryModels starts a query of the models of a namespace, which are sorted
+// by creation time unless the query orders them otherwise.
+func (m *ModelBoxClient) QueryModels(namespace string) *ModelQuery {
+	return &ModelQuery{m: m, req: &proto.ListModelsRequest{
+		Namespace: m.opts.namespaceOrDefault(namespace),
+		PageSize:  uint32(m.opts.pageSize),
+	}}
+}
+
+func (q *ModelQuery) Owner(owner string) *ModelQuery {
+	q.req.Owner = owner
+	return q
+}
+
+func (q *ModelQuery) Task(task string) *ModelQuery {
+	q.req.Task = task
+	return q
+}
+
+// CreatedBetween selects models created at or after after and before
+// before, zero times leave their bound open.
+func (q *ModelQuery) CreatedBetween(after, before time.Time) *ModelQuery {
+	q.req.Created = timeRange(after, before)
+	return q
+}
+
+// UpdatedBetween selects models updated at or after after and before before,
+// zero times leave their bound open.
+func (q *ModelQuery) UpdatedBetween(after, before time.Time) *ModelQuery {
+	q.req.Updated = timeRange(after, before)
+	return q
+}
+
+// Metadata selects models whose metadata holds value for key, values compare
+// as they are encoded by UpdateMetadata.
+func (q *ModelQuery) Metadata(key string, value interface{}) *ModelQuery {
+	metadata, err := encodeQueryMetadata(q.req.Metadata, key, value)
+	if err != nil && q.err == nil {
+		q.err = err
+	}
+	q.req.Metadata = metadata
+	return q
+}
+
+func (q *ModelQuery) OrderBy(field SortField) *ModelQuery {
+	q.req.Sort = &proto.SortOrder{Field: field.toProto()}
+	return q
+}
+
+func (q *ModelQuery) OrderByDesc(field SortField) *ModelQuery {
+	q.req.Sort = &proto.SortOrder{Field: field.toProto(), Descending: true}
+	return q
+}
+
+// Iterator iterates over the models selected by the query.
+func (q *ModelQuery) Iterator(ctx context.Context) *ModelIterator {
+	it := &ModelIterator{}
+	req := gproto.Clone(q.req).(*proto.ListModelsRequest)
+	queryErr := q.err
+	m := q.m
+	it.pages = pager{ctx: ctx, fetch: func(ctx context.Context, token string) (string, error) {
+		if queryErr != nil {
+			return "", queryErr
+		}
+		ctx, cancel := withDefaultDeadline(ctx, m.opts.timeout)
+		defer cancel()
+		req.PageToken = token
+		resp, err := m.client.ListModels(ctx, req)
+		if err != nil {
+			return "", wrapError("list models", err)
+		}
+		for _, model := range resp.Models {
+			it.items = append(it.items, modelFromProto(model))
+		}
+		return resp.NextPageToken, nil
+	}}
+	return it
+}
+
+// All returns every model selected by the query.
+func (q *ModelQuery) All(ctx context.Context) ([]*Model, error) {
+	return q.Iterator(ctx).All()
+}
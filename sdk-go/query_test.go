@@ -0,0 +1,37 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tensorland/modelbox/sdk-go/proto"
+)
+
+func TestExperimentQuery(t *testing.T) {
+	mb, err := NewModelBoxClient("localhost:8085", WithDefaultNamespace("langtech"))
+	assert.Nil(t, err)
+	defer mb.Close()
+
+	since := time.Unix(1000, 0).UTC()
+	q := mb.QueryExperiments("").
+		Owner("owner@email").
+		Framework("pytorch").
+		CreatedBetween(since, time.Time{}).
+		Metadata("lr", 0.1).
+		Metadata("optimizer", "adam").
+		OrderByDesc(SortByName)
+	assert.Equal(t, "langtech", q.req.Namespace)
+	assert.Equal(t, "owner@email", q.req.Owner)
+	assert.Equal(t, proto.MLFramework_PYTORCH, q.req.Framework)
+	assert.Equal(t, since, q.req.Created.After.AsTime())
+	assert.Nil(t, q.req.Created.Before)
+	assert.Equal(t, map[string]string{"lr": "0.1", "optimizer": `"adam"`}, q.req.Metadata)
+	assert.Equal(t, proto.SortField_SORT_FIELD_NAME, q.req.Sort.Field)
+	assert.True(t, q.req.Sort.Descending)
+
+	// values which can't be encoded fail the query before calling the server
+	_, err = mb.QueryModels("").Task("nlp").Metadata("callback", func() {}).All(context.Background())
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,201 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"math"
+	mrand "math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// DEFAULT_RETRY_JITTER is the jitter of retry policies which don't set
+	// one.
+	DEFAULT_RETRY_JITTER = 0.2
+)
+
+// RetryPolicy describes how unary calls failing with one of RetryableCodes
+// are retried with exponential backoff. Codes are the names of gRPC status
+// codes such as UNAVAILABLE. Retries happen within the deadline of the call.
+//
+// Only calls which can safely be applied twice are retried: the calls which
+// read or overwrite state, and the calls carrying an idempotency key, such as
+// LogMetrics and LogEvent. The key is the same for every attempt, so that a
+// retried call is applied once by the server. TrackArtifacts, CompleteUpload
+// and the admin calls are never retried.
+type RetryPolicy struct {
+	MaxAttempts       int           `yaml:"max_attempts"`
+	InitialBackoff    time.Duration `yaml:"initial_backoff"`
+	MaxBackoff        time.Duration `yaml:"max_backoff"`
+	BackoffMultiplier float64       `yaml:"backoff_multiplier"`
+	// Jitter is the fraction of each backoff which is randomized, 0.2
+	// spreads a backoff of 1s over [0.8s, 1.2s] and 0 disables it. It is
+	// DEFAULT_RETRY_JITTER in DefaultRetryPolicy and in config files which
+	// don't set it.
+	Jitter         float64  `yaml:"jitter"`
+	RetryableCodes []string `yaml:"retryable_codes"`
+}
+
+// DefaultRetryPolicy retries calls failing with UNAVAILABLE up to 4 times
+// in total, backing off from 100ms to 5s.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:       4,
+		InitialBackoff:    100 * time.Millisecond,
+		MaxBackoff:        5 * time.Second,
+		BackoffMultiplier: 2,
+		Jitter:            DEFAULT_RETRY_JITTER,
+		RetryableCodes:    []string{"UNAVAILABLE"},
+	}
+}
+
+// UnmarshalYAML defaults the jitter of policies read from config files.
+// Profiles decode over the policy of the top level config, so only the keys
+// they set replace the inherited ones.
+func (r *RetryPolicy) UnmarshalYAML(value *yaml.Node) error {
+	type plain RetryPolicy
+	inherited := r.MaxAttempts != 0 || r.InitialBackoff != 0 || r.MaxBackoff != 0 ||
+		r.BackoffMultiplier != 0 || r.Jitter != 0 || len(r.RetryableCodes) > 0
+	p := plain(*r)
+	if !inherited && !hasKey(value, "jitter") {
+		p.Jitter = DEFAULT_RETRY_JITTER
+	}
+	if err := value.Decode(&p); err != nil {
+		return err
+	}
+	*r = RetryPolicy(p)
+	return nil
+}
+
+// hasKey reports whether a mapping node holds key.
+func hasKey(node *yaml.Node, key string) bool {
+	if node.Kind != yaml.MappingNode {
+		return false
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *RetryPolicy) validate() error {
+	if r.MaxAttempts <= 1 {
+		return nil
+	}
+	if r.InitialBackoff <= 0 || r.MaxBackoff <= 0 {
+		return fmt.Errorf("retry initial_backoff and max_backoff must be positive")
+	}
+	if r.Jitter < 0 || r.Jitter > 1 {
+		return fmt.Errorf("retry jitter must be between 0 and 1")
+	}
+	_, err := r.retryableCodes()
+	return err
+}
+
+// retryableCodes parses RetryableCodes, which default to UNAVAILABLE.
+func (r *RetryPolicy) retryableCodes() (map[codes.Code]bool, error) {
+	names := r.RetryableCodes
+	if len(names) == 0 {
+		names = []string{"UNAVAILABLE"}
+	}
+	retryable := make(map[codes.Code]bool, len(names))
+	for _, name := range names {
+		var c codes.Code
+		if err := c.UnmarshalJSON([]byte(strconv.Quote(strings.ToUpper(name)))); err != nil {
+			return nil, fmt.Errorf("invalid retryable code %q", name)
+		}
+		retryable[c] = true
+	}
+	return retryable, nil
+}
+
+// backoff returns the delay before the retry following attempt, counted
+// from 1.
+func (r *RetryPolicy) backoff(attempt int) time.Duration {
+	multiplier := r.BackoffMultiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+	backoff := float64(r.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if backoff > float64(r.MaxBackoff) {
+		backoff = float64(r.MaxBackoff)
+	}
+	backoff *= 1 + r.Jitter*(2*mrand.Float64()-1)
+	return time.Duration(backoff)
+}
+
+// idempotentMethods are the unary calls which only read or overwrite state,
+// and can be retried without an idempotency key.
+var idempotentMethods = map[string]bool{
+	"/modelbox.ModelStore/ListModels":        true,
+	"/modelbox.ModelStore/ListModelVersions": true,
+	"/modelbox.ModelStore/ListExperiments":   true,
+	"/modelbox.ModelStore/GetExperiment":     true,
+	"/modelbox.ModelStore/UpdateMetadata":    true,
+	"/modelbox.ModelStore/ListMetadata":      true,
+	"/modelbox.ModelStore/ListArtifacts":     true,
+	"/modelbox.ModelStore/GetMetrics":        true,
+	"/modelbox.ModelStore/ListEvents":        true,
+	"/modelbox.ModelStore/Search":            true,
+}
+
+// retriable reports whether a call can be applied more than once.
+func retriable(method string, req interface{}) bool {
+	if idempotentMethods[method] {
+		return true
+	}
+	keyed, ok := req.(interface{ GetIdempotencyKey() string })
+	return ok && keyed.GetIdempotencyKey() != ""
+}
+
+// unaryInterceptor retries the retriable unary calls of a client according
+// to the policy.
+func (r *RetryPolicy) unaryInterceptor() (grpc.UnaryClientInterceptor, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+	retryable, err := r.retryableCodes()
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !retriable(method, req) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		for attempt := 1; ; attempt++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			if err == nil || attempt >= r.MaxAttempts || !retryable[status.Code(err)] {
+				return err
+			}
+			timer := time.NewTimer(r.backoff(attempt))
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			}
+		}
+	}, nil
+}
+
+// newIdempotencyKey returns the key identifying a call across its attempts.
+func newIdempotencyKey() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		// keys only guard against duplicates, a call without one is still
+		// applied
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"gopkg.in/yaml.v3"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := DefaultRetryPolicy()
+	for attempt := 1; attempt <= 10; attempt++ {
+		backoff := policy.InitialBackoff << (attempt - 1)
+		if backoff > policy.MaxBackoff {
+			backoff = policy.MaxBackoff
+		}
+		delay := policy.backoff(attempt)
+		assert.GreaterOrEqual(t, delay, time.Duration(float64(backoff)*0.8))
+		assert.LessOrEqual(t, delay, time.Duration(float64(backoff)*1.2))
+	}
+}
+
+func TestRetryPolicyJitter(t *testing.T) {
+	policy := DefaultRetryPolicy()
+	policy.Jitter = 0
+	assert.Equal(t, policy.InitialBackoff, policy.backoff(1))
+	assert.Equal(t, 2*policy.InitialBackoff, policy.backoff(2))
+
+	// config files which don't set a jitter get the default one
+	var decoded RetryPolicy
+	assert.Nil(t, yaml.Unmarshal([]byte("max_attempts: 3\ninitial_backoff: 10ms\n"), &decoded))
+	assert.Equal(t, DEFAULT_RETRY_JITTER, decoded.Jitter)
+	assert.Equal(t, 10*time.Millisecond, decoded.InitialBackoff)
+	assert.Nil(t, yaml.Unmarshal([]byte("jitter: 0\n"), &decoded))
+	assert.Equal(t, 0.0, decoded.Jitter)
+}
+
+// flakyServer fails the first calls to LogMetrics and every call to
+// TrackArtifacts with Unavailable.
+type flakyServer struct {
+	proto.UnimplementedModelStoreServer
+	failures   int
+	keys       []string
+	trackCalls int
+}
+
+func (s *flakyServer) TrackArtifacts(ctx context.Context, req *proto.TrackArtifactsRequest) (*proto.TrackArtifactsResponse, error) {
+	s.trackCalls++
+	return nil, status.Error(codes.Unavailable, "server restarting")
+}
+
+func (s *flakyServer) LogMetrics(ctx context.Context, req *proto.LogMetricsRequest) (*proto.LogMetricsResponse, error) {
+	s.keys = append(s.keys, req.IdempotencyKey)
+	if len(s.keys) <= s.failures {
+		return nil, status.Error(codes.Unavailable, "server restarting")
+	}
+	return &proto.LogMetricsResponse{}, nil
+}
+
+func TestRetryPolicyRetriesWithSameKey(t *testing.T) {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	server := &flakyServer{failures: 2}
+	proto.RegisterModelStoreServer(s, server)
+	go s.Serve(lis)
+	defer s.Stop()
+	dialer := func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}
+	policy := DefaultRetryPolicy()
+	policy.InitialBackoff = time.Millisecond
+	mb, err := NewModelBoxClient("bufnet", WithDialOptions(grpc.WithContextDialer(dialer)), WithRetryPolicy(policy))
+	assert.Nil(t, err)
+	defer mb.Close()
+
+	assert.Nil(t, mb.LogMetrics(context.Background(), "exp-1", "loss", &MetricValue{Step: 1, Value: 0.5}))
+	assert.Len(t, server.keys, 3)
+	assert.NotEmpty(t, server.keys[0])
+	assert.Equal(t, server.keys[0], server.keys[1])
+	assert.Equal(t, server.keys[0], server.keys[2])
+
+	// the policy gives up after MaxAttempts
+	server.keys, server.failures = nil, 10
+	err = mb.LogMetrics(context.Background(), "exp-1", "loss", &MetricValue{Step: 2, Value: 0.4})
+	assert.ErrorIs(t, err, ErrUnavailable)
+	assert.Len(t, server.keys, 4)
+
+	// calls without a key which aren't idempotent are never retried
+	_, err = mb.TrackArtifacts(context.Background(), "weights", "exp-1", nil)
+	assert.ErrorIs(t, err, ErrUnavailable)
+	assert.Equal(t, 1, server.trackCalls)
+}
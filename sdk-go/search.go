@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"github.com/tensorland/modelbox/sdk-go/search"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SearchResults iterates over the experiments of a namespace matching query,
+// in the order they were created. See the search package for the syntax of
+// queries, malformed queries fail with a *search.SyntaxError before calling
+// the server.
+//
+// Servers which don't implement Search are searched by the client, which
+// fetches the metadata and metrics of every experiment of the namespace.
+func (m *ModelBoxClient) SearchResults(ctx context.Context, namespace, query string) *SearchResultIterator {
+	it := &SearchResultIterator{}
+	q, queryErr := search.Parse(query)
+	req := &proto.SearchRequest{
+		Namespace: m.opts.namespaceOrDefault(namespace),
+		Query:     query,
+		PageSize:  uint32(m.opts.pageSize),
+	}
+	it.pages = pager{ctx: ctx, fetch: func(ctx context.Context, token string) (string, error) {
+		if queryErr != nil {
+			return "", queryErr
+		}
+		rpcCtx, cancel := withDefaultDeadline(ctx, m.opts.timeout)
+		defer cancel()
+		req.PageToken = token
+		resp, err := m.client.Search(rpcCtx, req)
+		if status.Code(err) == codes.Unimplemented && token == "" {
+			resp, err = m.searchLocally(ctx, req.Namespace, q)
+		}
+		if err != nil {
+			return "", wrapError("search", err)
+		}
+		for _, r := range resp.Results {
+			it.items = append(it.items, searchResultFromProto(r))
+		}
+		return resp.NextPageToken, nil
+	}}
+	return it
+}
+
+// Search returns every experiment of a namespace matching query.
+func (m *ModelBoxClient) Search(ctx context.Context, namespace, query string) ([]*SearchResult, error) {
+	return m.SearchResults(ctx, namespace, query).All()
+}
+
+// searchLocally evaluates a query against every experiment of a namespace,
+// returning the results as a single page.
+func (m *ModelBoxClient) searchLocally(ctx context.Context, namespace string, q *search.Query) (*proto.SearchResponse, error) {
+	resp := &proto.SearchResponse{}
+	req := &proto.ListExperimentsRequest{Namespace: namespace, PageSize: uint32(m.opts.pageSize)}
+	for {
+		page, err := m.listExperimentsPage(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range page.Experiments {
+			r, err := m.searchRecord(ctx, q, e)
+			if err != nil {
+				return nil, err
+			}
+			if q.Match(r) {
+				resp.Results = append(resp.Results, q.Result(r))
+			}
+		}
+		if page.NextPageToken == "" {
+			return resp, nil
+		}
+		req.PageToken = page.NextPageToken
+	}
+}
+
+func (m *ModelBoxClient) listExperimentsPage(ctx context.Context, req *proto.ListExperimentsRequest) (*proto.ListExperimentsResponse, error) {
+	ctx, cancel := withDefaultDeadline(ctx, m.opts.timeout)
+	defer cancel()
+	return m.client.ListExperiments(ctx, req)
+}
+
+// searchRecord fetches the metadata of an experiment, and its metrics if the
+// query refers to any.
+func (m *ModelBoxClient) searchRecord(ctx context.Context, q *search.Query, e *proto.Experiment) (*search.Record, error) {
+	ctx, cancel := withDefaultDeadline(ctx, m.opts.timeout)
+	defer cancel()
+	metadata, err := m.client.ListMetadata(ctx, &proto.ListMetadataRequest{ParentId: e.Id})
+	if err != nil {
+		return nil, err
+	}
+	r := &search.Record{Experiment: e, Metadata: metadata.GetMetadata().GetMetadata()}
+	if len(q.Metrics()) > 0 {
+		metrics, err := m.client.GetMetrics(ctx, &proto.GetMetricsRequest{ParentId: e.Id})
+		if err != nil {
+			return nil, err
+		}
+		r.Metrics = metrics.Metrics
+	}
+	return r, nil
+}
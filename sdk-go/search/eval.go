@@ -0,0 +1,206 @@
+package search
+
+import (
+	"path"
+	"strconv"
+	"time"
+)
+
+// node is a node of a parsed query. Comparisons and logical operators
+// evaluate to bools, values to float64, string, bool, time.Time or nil when
+// they are missing.
+type node interface {
+	eval(r *Record) interface{}
+}
+
+type logical struct {
+	or          bool
+	left, right node
+}
+
+func (n *logical) eval(r *Record) interface{} {
+	if truthy(n.left.eval(r)) == n.or {
+		return n.or
+	}
+	return truthy(n.right.eval(r))
+}
+
+type not struct {
+	x node
+}
+
+func (n *not) eval(r *Record) interface{} {
+	return !truthy(n.x.eval(r))
+}
+
+type compare struct {
+	op          string
+	left, right node
+}
+
+func (n *compare) eval(r *Record) interface{} {
+	return compareValues(n.op, n.left.eval(r), n.right.eval(r))
+}
+
+type literal struct {
+	v interface{}
+}
+
+func (n *literal) eval(r *Record) interface{} {
+	return n.v
+}
+
+type refKind int
+
+const (
+	fieldRef refKind = iota
+	metadataRef
+	metricRef
+)
+
+type ref struct {
+	kind refKind
+	key  string
+	// agg is latest, min or max for metrics.
+	agg string
+}
+
+func (n *ref) eval(r *Record) interface{} {
+	switch n.kind {
+	case metadataRef:
+		return r.metadata(n.key)
+	case metricRef:
+		return r.metric(n.key, n.agg)
+	}
+	return r.field(n.key)
+}
+
+func walk(n node, fn func(node)) {
+	fn(n)
+	switch n := n.(type) {
+	case *logical:
+		walk(n.left, fn)
+		walk(n.right, fn)
+	case *not:
+		walk(n.x, fn)
+	case *compare:
+		walk(n.left, fn)
+		walk(n.right, fn)
+	}
+}
+
+// truthy lets values such as boolean metadata be used as conditions.
+func truthy(v interface{}) bool {
+	b, ok := v.(bool)
+	return ok && b
+}
+
+func compareValues(op string, a, b interface{}) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	if op == "~" {
+		s, ok1 := a.(string)
+		pattern, ok2 := b.(string)
+		if !ok1 || !ok2 {
+			return false
+		}
+		ok, err := path.Match(pattern, s)
+		return err == nil && ok
+	}
+	switch a := a.(type) {
+	case float64:
+		switch b := b.(type) {
+		case float64:
+			return ordered(op, cmpFloat(a, b))
+		case string:
+			if f, err := strconv.ParseFloat(b, 64); err == nil {
+				return ordered(op, cmpFloat(a, f))
+			}
+		}
+	case string:
+		switch b := b.(type) {
+		case string:
+			return ordered(op, cmpString(a, b))
+		case float64, time.Time:
+			return compareValues(flip(op), b, a)
+		}
+	case bool:
+		if b, ok := b.(bool); ok && (op == "=" || op == "!=") {
+			return (a == b) == (op == "=")
+		}
+	case time.Time:
+		switch b := b.(type) {
+		case time.Time:
+			return ordered(op, cmpTime(a, b))
+		case string:
+			if t, ok := parseTime(b); ok {
+				return ordered(op, cmpTime(a, t))
+			}
+		}
+	}
+	return false
+}
+
+// flip returns the operator which compares the operands in reverse order.
+func flip(op string) string {
+	switch op {
+	case "<":
+		return ">"
+	case "<=":
+		return ">="
+	case ">":
+		return "<"
+	case ">=":
+		return "<="
+	}
+	return op
+}
+
+func ordered(op string, c int) bool {
+	switch op {
+	case "=":
+		return c == 0
+	case "!=":
+		return c != 0
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	}
+	return false
+}
+
+func cmpFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func cmpString(a, b string) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func cmpTime(a, b time.Time) int {
+	switch {
+	case a.Before(b):
+		return -1
+	case a.After(b):
+		return 1
+	}
+	return 0
+}
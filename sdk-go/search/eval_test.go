@@ -0,0 +1,79 @@
+package search
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func metric(key string, values ...float32) *proto.Metrics {
+	m := &proto.Metrics{Key: key}
+	for i, v := range values {
+		m.Values = append(m.Values, &proto.MetricsValue{
+			Step:  uint64(i + 1),
+			Value: &proto.MetricsValue_FVal{FVal: v},
+		})
+	}
+	return m
+}
+
+func TestMatch(t *testing.T) {
+	r := &Record{
+		Experiment: &proto.Experiment{
+			Id:        "e1",
+			Name:      "bert-base",
+			Owner:     "owner@email",
+			Framework: proto.MLFramework_PYTORCH,
+			CreatedAt: timestamppb.New(time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		Metadata: map[string]string{
+			"lr":        "0.001",
+			"optimizer": `"adam"`,
+			"tuned":     "true",
+			"layers":    "[1,2]",
+			"raw":       "not json",
+		},
+		Metrics: map[string]*proto.Metrics{
+			"val/acc": metric("val/acc", 0.7, 0.95, 0.9),
+		},
+	}
+	for query, match := range map[string]bool{
+		"metrics.val/acc = 0.9":                     true,
+		"latest(metrics.val/acc) > 0.9":             false,
+		"max(metrics.val/acc) > 0.9":                true,
+		"min(metrics.val/acc) = 0.7":                true,
+		"metrics.val/loss < 1":                      false,
+		"not metrics.val/loss < 1":                  true,
+		"metadata.lr < 1e-2 and metadata.tuned":     true,
+		"metadata.optimizer = 'adam'":               true,
+		"metadata.optimizer > 1":                    false,
+		"metadata.layers = '[1,2]'":                 true,
+		"metadata.raw ~ 'not *'":                    true,
+		"name ~ 'bert-*' and framework = 'pytorch'": true,
+		"owner != 'owner@email' or id = 'e1'":       true,
+		"created_at >= '2023-01-01'":                true,
+		"created_at < '2023-02-01T00:00:00Z'":       false,
+		"'2023-01-01' < created_at":                 true,
+		"updated_at < '2023-01-01'":                 false,
+		"0.8 < metrics.val/acc":                     true,
+		"metadata.tuned = false":                    false,
+		"name":                                      false,
+	} {
+		q, err := Parse(query)
+		if assert.Nil(t, err, query) {
+			assert.Equal(t, match, q.Match(r), query)
+		}
+	}
+
+	q, err := Parse("max(metrics.val/acc) > 0.9 and metadata.lr < 1")
+	assert.Nil(t, err)
+	result := q.Result(r)
+	assert.Equal(t, r.Experiment, result.Experiment)
+	assert.Equal(t, r.Metadata, result.Metadata)
+	assert.Equal(t, map[string]*proto.MetricSummary{
+		"val/acc": {Latest: 0.9, Step: 3, Min: 0.7, Max: 0.95},
+	}, result.Metrics)
+}
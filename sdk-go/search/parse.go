@@ -0,0 +1,326 @@
+package search
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SyntaxError is returned by Parse for malformed queries.
+type SyntaxError struct {
+	// Offset of the error in bytes from the start of the query.
+	Offset int
+	Msg    string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("invalid query at offset %d: %s", e.Offset, e.Msg)
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenNumber
+	tokenString
+	tokenOp
+	tokenLParen
+	tokenRParen
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+type lexer struct {
+	input string
+	pos   int
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentPart(c byte) bool {
+	return isIdentStart(c) || isDigit(c) || c == '.' || c == '/' || c == '-'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func (l *lexer) next() (token, error) {
+	for l.pos < len(l.input) && strings.IndexByte(" \t\r\n", l.input[l.pos]) >= 0 {
+		l.pos++
+	}
+	start := l.pos
+	if l.pos == len(l.input) {
+		return token{kind: tokenEOF, pos: start}, nil
+	}
+	c := l.input[l.pos]
+	switch {
+	case c == '(':
+		l.pos++
+		return token{kind: tokenLParen, text: "(", pos: start}, nil
+	case c == ')':
+		l.pos++
+		return token{kind: tokenRParen, text: ")", pos: start}, nil
+	case isIdentStart(c):
+		for l.pos < len(l.input) && isIdentPart(l.input[l.pos]) {
+			l.pos++
+		}
+		return token{kind: tokenIdent, text: l.input[start:l.pos], pos: start}, nil
+	case isDigit(c) || c == '.' || (c == '-' && l.pos+1 < len(l.input) &&
+		(isDigit(l.input[l.pos+1]) || l.input[l.pos+1] == '.')):
+		l.pos++
+		for l.pos < len(l.input) {
+			c := l.input[l.pos]
+			if isDigit(c) || c == '.' || c == 'e' || c == 'E' {
+				l.pos++
+			} else if (c == '-' || c == '+') && (l.input[l.pos-1] == 'e' || l.input[l.pos-1] == 'E') {
+				l.pos++
+			} else {
+				break
+			}
+		}
+		return token{kind: tokenNumber, text: l.input[start:l.pos], pos: start}, nil
+	case c == '\'' || c == '"':
+		var b strings.Builder
+		for l.pos++; l.pos < len(l.input); l.pos++ {
+			switch l.input[l.pos] {
+			case c:
+				l.pos++
+				return token{kind: tokenString, text: b.String(), pos: start}, nil
+			case '\\':
+				if l.pos+1 < len(l.input) {
+					l.pos++
+				}
+			}
+			b.WriteByte(l.input[l.pos])
+		}
+		return token{}, &SyntaxError{Offset: start, Msg: "unterminated string"}
+	}
+	for _, op := range []string{"!=", "<=", ">=", "=", "<", ">", "~"} {
+		if strings.HasPrefix(l.input[l.pos:], op) {
+			l.pos += len(op)
+			return token{kind: tokenOp, text: op, pos: start}, nil
+		}
+	}
+	return token{}, &SyntaxError{Offset: start, Msg: fmt.Sprintf("unexpected character %q", c)}
+}
+
+// parser is a recursive descent parser of the grammar:
+//
+//	expr       = and { "or" and }
+//	and        = unary { "and" unary }
+//	unary      = "not" unary | "(" expr ")" | comparison
+//	comparison = value [ op value ]
+//	value      = literal | field | "metadata." key | "metrics." key |
+//	             ( "latest" | "min" | "max" ) "(" "metrics." key ")"
+type parser struct {
+	lex  lexer
+	tok  token
+	peek *token
+}
+
+func (p *parser) advance() error {
+	if p.peek != nil {
+		p.tok, p.peek = *p.peek, nil
+		return nil
+	}
+	t, err := p.lex.next()
+	p.tok = t
+	return err
+}
+
+func (p *parser) lookahead() (token, error) {
+	if p.peek == nil {
+		t, err := p.lex.next()
+		if err != nil {
+			return token{}, err
+		}
+		p.peek = &t
+	}
+	return *p.peek, nil
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return &SyntaxError{Offset: p.tok.pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) keyword(word string) bool {
+	return p.tok.kind == tokenIdent && strings.EqualFold(p.tok.text, word)
+}
+
+func (p *parser) parse() (node, error) {
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	if p.tok.kind == tokenEOF {
+		return nil, p.errorf("empty query")
+	}
+	n, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokenEOF {
+		return nil, p.errorf("unexpected %q", p.tok.text)
+	}
+	return n, nil
+}
+
+func (p *parser) expr() (node, error) {
+	left, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("or") {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		right, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		left = &logical{or: true, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) and() (node, error) {
+	left, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword("and") {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		right, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		left = &logical{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) unary() (node, error) {
+	switch {
+	case p.keyword("not"):
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		x, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return &not{x: x}, nil
+	case p.tok.kind == tokenLParen:
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		x, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		if p.tok.kind != tokenRParen {
+			return nil, p.errorf("expected )")
+		}
+		return x, p.advance()
+	}
+	return p.comparison()
+}
+
+func (p *parser) comparison() (node, error) {
+	left, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokenOp {
+		return left, nil
+	}
+	op := p.tok.text
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	right, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	return &compare{op: op, left: left, right: right}, nil
+}
+
+func (p *parser) value() (node, error) {
+	t := p.tok
+	switch t.kind {
+	case tokenNumber:
+		f, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, p.errorf("invalid number %q", t.text)
+		}
+		return &literal{v: f}, p.advance()
+	case tokenString:
+		return &literal{v: t.text}, p.advance()
+	case tokenIdent:
+	case tokenEOF:
+		return nil, p.errorf("unexpected end of query")
+	default:
+		return nil, p.errorf("unexpected %q", t.text)
+	}
+	name := strings.ToLower(t.text)
+	switch name {
+	case "true", "false":
+		return &literal{v: name == "true"}, p.advance()
+	case "and", "or", "not":
+		return nil, p.errorf("unexpected %q", t.text)
+	case "latest", "min", "max":
+		next, err := p.lookahead()
+		if err != nil {
+			return nil, err
+		}
+		if next.kind == tokenLParen {
+			return p.aggregate(name)
+		}
+	}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	switch {
+	case fields[name]:
+		return &ref{kind: fieldRef, key: name}, nil
+	case strings.HasPrefix(t.text, "metadata.") && len(t.text) > len("metadata."):
+		return &ref{kind: metadataRef, key: t.text[len("metadata."):]}, nil
+	case strings.HasPrefix(t.text, "metrics.") && len(t.text) > len("metrics."):
+		return &ref{kind: metricRef, key: t.text[len("metrics."):], agg: "latest"}, nil
+	}
+	return nil, &SyntaxError{Offset: t.pos, Msg: fmt.Sprintf("unknown field %q", t.text)}
+}
+
+// aggregate parses latest(), min() or max() of a metric.
+func (p *parser) aggregate(agg string) (node, error) {
+	// Skip the function name and the parenthesis.
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	pos := p.tok.pos
+	arg, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	r, ok := arg.(*ref)
+	if !ok || r.kind != metricRef {
+		return nil, &SyntaxError{Offset: pos, Msg: fmt.Sprintf("%s() takes a metric", agg)}
+	}
+	if p.tok.kind != tokenRParen {
+		return nil, p.errorf("expected )")
+	}
+	r.agg = agg
+	return r, p.advance()
+}
@@ -0,0 +1,38 @@
+package search
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	q, err := Parse(`metrics.val/acc > 0.9 AND (max(metrics.train-loss) < 1e-3 or not metadata.tuned) and name ~ "bert-*" and min(metrics.val/acc) >= -0.5`)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"val/acc", "train-loss"}, q.Metrics())
+
+	c := q.root.(*logical).left.(*logical).left.(*logical).left.(*compare)
+	assert.Equal(t, ">", c.op)
+	assert.Equal(t, &ref{kind: metricRef, key: "val/acc", agg: "latest"}, c.left)
+	assert.Equal(t, &literal{v: 0.9}, c.right)
+
+	for query, offset := range map[string]int{
+		"":                      0,
+		"name =":                6,
+		"name = 'bert":          7,
+		"color = 'red'":         0,
+		"(owner = 'a'":          12,
+		"max(name) > 1":         4,
+		"owner = 'a' owner":     12,
+		"metadata. = 1":         0,
+		"metrics.loss < 1 and ": 21,
+		"name == 'a'":           6,
+	} {
+		_, err := Parse(query)
+		var syntaxErr *SyntaxError
+		if assert.True(t, errors.As(err, &syntaxErr), query) {
+			assert.Equal(t, offset, syntaxErr.Offset, query)
+		}
+	}
+}
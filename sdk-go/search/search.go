@@ -0,0 +1,207 @@
+// Package search implements the query language of the Search RPC. Servers
+// and the SDK share it, so that clients can evaluate queries themselves
+// against servers which don't implement Search.
+//
+// A query compares fields, metadata and metrics of an experiment with
+// literals, and combines comparisons with and, or, not and parentheses:
+//
+//	metrics.val/acc > 0.9 and metadata.lr < 1e-3
+//	max(metrics.val/acc) >= 0.95 or not (owner = 'ml-team')
+//	name ~ 'bert-*' and created_at >= '2023-01-01'
+//
+// The fields are id, name, owner, namespace, framework, external_id,
+// created_at and updated_at. metadata.<key> is the decoded metadata value of
+// key. metrics.<key> is the latest float value logged for key, and
+// latest(), min() and max() of metrics.<key> its latest, smallest and
+// largest values. Keys may contain letters, digits and any of _ . / -.
+//
+// The operators are = != < <= > >= and ~, which matches a string against a
+// shell pattern. Literals are numbers, strings in single or double quotes,
+// true and false. Times are compared with strings in RFC 3339 or YYYY-MM-DD
+// format. Comparisons with missing metadata or metrics, or between values of
+// different types, are false.
+package search
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tensorland/modelbox/sdk-go/proto"
+)
+
+// Query is a parsed search query.
+type Query struct {
+	text    string
+	root    node
+	metrics []string
+}
+
+// Parse parses a query, returning a *SyntaxError if it's malformed.
+func Parse(query string) (*Query, error) {
+	p := &parser{lex: lexer{input: query}}
+	root, err := p.parse()
+	if err != nil {
+		return nil, err
+	}
+	q := &Query{text: query, root: root}
+	seen := map[string]bool{}
+	walk(root, func(n node) {
+		if r, ok := n.(*ref); ok && r.kind == metricRef && !seen[r.key] {
+			seen[r.key] = true
+			q.metrics = append(q.metrics, r.key)
+		}
+	})
+	return q, nil
+}
+
+func (q *Query) String() string {
+	return q.text
+}
+
+// Metrics returns the keys of the metrics the query refers to, servers only
+// need to load these metrics to evaluate it.
+func (q *Query) Metrics() []string {
+	return q.metrics
+}
+
+// Record is an experiment searches are evaluated against.
+type Record struct {
+	Experiment *proto.Experiment
+	// Metadata values encoded like the values of UpdateMetadataRequest.
+	Metadata map[string]string
+	// Metrics by key, only the metrics returned by Query.Metrics are needed.
+	Metrics map[string]*proto.Metrics
+}
+
+// Match reports whether the record matches the query.
+func (q *Query) Match(r *Record) bool {
+	return r != nil && r.Experiment != nil && truthy(q.root.eval(r))
+}
+
+// Result returns the search result of a record, summarizing the metrics the
+// query refers to.
+func (q *Query) Result(r *Record) *proto.SearchResult {
+	result := &proto.SearchResult{
+		Experiment: r.Experiment,
+		Metadata:   r.Metadata,
+		Metrics:    map[string]*proto.MetricSummary{},
+	}
+	for _, key := range q.metrics {
+		if s := Summarize(r.Metrics[key]); s != nil {
+			result.Metrics[key] = s
+		}
+	}
+	return result
+}
+
+// Summarize returns the latest and best float values of a metric, or nil if
+// it has none. The latest value is the one with the largest step, ties are
+// broken by wallclock time and then by the order values were logged in.
+func Summarize(m *proto.Metrics) *proto.MetricSummary {
+	var s *proto.MetricSummary
+	var latest *proto.MetricsValue
+	for _, v := range m.GetValues() {
+		f, ok := v.GetValue().(*proto.MetricsValue_FVal)
+		if !ok {
+			continue
+		}
+		if s == nil {
+			s = &proto.MetricSummary{Min: f.FVal, Max: f.FVal}
+		}
+		if latest == nil || v.Step > latest.Step ||
+			(v.Step == latest.Step && v.WallclockTime >= latest.WallclockTime) {
+			latest = v
+			s.Latest, s.Step = f.FVal, v.Step
+		}
+		if f.FVal < s.Min {
+			s.Min = f.FVal
+		}
+		if f.FVal > s.Max {
+			s.Max = f.FVal
+		}
+	}
+	return s
+}
+
+// field returns the value of an entity field of the experiment.
+func (r *Record) field(name string) interface{} {
+	e := r.Experiment
+	switch name {
+	case "id":
+		return e.Id
+	case "name":
+		return e.Name
+	case "owner":
+		return e.Owner
+	case "namespace":
+		return e.Namespace
+	case "framework":
+		return strings.ToLower(e.Framework.String())
+	case "external_id":
+		return e.ExternalId
+	case "created_at":
+		if e.CreatedAt == nil {
+			return nil
+		}
+		return e.CreatedAt.AsTime()
+	case "updated_at":
+		if e.UpdatedAt == nil {
+			return nil
+		}
+		return e.UpdatedAt.AsTime()
+	}
+	return nil
+}
+
+var fields = map[string]bool{
+	"id": true, "name": true, "owner": true, "namespace": true, "framework": true,
+	"external_id": true, "created_at": true, "updated_at": true,
+}
+
+// metadata decodes a metadata value, values which aren't JSON are strings.
+func (r *Record) metadata(key string) interface{} {
+	raw, ok := r.Metadata[key]
+	if !ok {
+		return nil
+	}
+	var v interface{}
+	if err := json.Unmarshal([]byte(raw), &v); err != nil {
+		return raw
+	}
+	switch v.(type) {
+	case float64, string, bool:
+		return v
+	}
+	// Objects and lists are compared as their encoding.
+	return raw
+}
+
+func (r *Record) metric(key, agg string) interface{} {
+	s := Summarize(r.Metrics[key])
+	if s == nil {
+		return nil
+	}
+	v := s.Latest
+	switch agg {
+	case "min":
+		v = s.Min
+	case "max":
+		v = s.Max
+	}
+	// Metrics are float32, widen them by their shortest representation so
+	// that a value logged as 0.9 equals the literal 0.9.
+	f, _ := strconv.ParseFloat(strconv.FormatFloat(float64(v), 'g', -1, 32), 64)
+	return f
+}
+
+// parseTime parses the time formats which times are compared with.
+func parseTime(s string) (time.Time, bool) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
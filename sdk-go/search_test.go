@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"github.com/tensorland/modelbox/sdk-go/search"
+)
+
+// legacyServer predates Search, clients evaluate queries against the
+// experiments it lists.
+type legacyServer struct {
+	pagingServer
+	metricCalls int
+}
+
+func (s *legacyServer) ListMetadata(ctx context.Context, req *proto.ListMetadataRequest) (*proto.ListMetadataResponse, error) {
+	return &proto.ListMetadataResponse{Metadata: &proto.Metadata{Metadata: map[string]string{"layers": req.ParentId}}}, nil
+}
+
+func (s *legacyServer) GetMetrics(ctx context.Context, req *proto.GetMetricsRequest) (*proto.GetMetricsResponse, error) {
+	s.metricCalls++
+	return &proto.GetMetricsResponse{Metrics: map[string]*proto.Metrics{
+		"loss": {Key: "loss", Values: []*proto.MetricsValue{
+			{Step: 1, Value: &proto.MetricsValue_FVal{FVal: 2}},
+			{Step: 2, Value: &proto.MetricsValue_FVal{FVal: 1}},
+		}},
+	}}, nil
+}
+
+func TestSearchWithoutServerSupport(t *testing.T) {
+	server := &legacyServer{pagingServer: pagingServer{experiments: 5}}
+	mb := newPagingClient(t, server, WithPageSize(2))
+	ctx := context.Background()
+
+	results, err := mb.Search(ctx, "langtech", "metadata.layers >= 3")
+	assert.Nil(t, err)
+	assert.Len(t, results, 2)
+	assert.Equal(t, "3", results[0].Experiment.Id)
+	assert.Equal(t, 3.0, results[0].Metadata["layers"])
+	assert.Empty(t, results[0].Metrics)
+	// metrics are only fetched by queries which refer to them
+	assert.Equal(t, 0, server.metricCalls)
+
+	results, err = mb.Search(ctx, "langtech", "metrics.loss = 1 and max(metrics.loss) = 2 and metadata.layers < 1")
+	assert.Nil(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, &MetricSummary{Latest: 1, Step: 2, Min: 1, Max: 2}, results[0].Metrics["loss"])
+	assert.Equal(t, 5, server.metricCalls)
+
+	calls := len(server.calls)
+	_, err = mb.Search(ctx, "langtech", "metadata.layers >=")
+	var syntaxErr *search.SyntaxError
+	assert.True(t, errors.As(err, &syntaxErr))
+	assert.Equal(t, calls, len(server.calls))
+}
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+	"io"
+	"sync"
+)
+
+const (
+	DEFAULT_PART_SIZE        = 64 << 20
+	DEFAULT_TRANSFER_WORKERS = 1
+)
+
+// transferPart is a range of a file moved by its own stream. Parts are
+// numbered from 1.
+type transferPart struct {
+	number uint32
+	offset uint64
+	size   uint64
+}
+
+// splitParts splits a file of size bytes into parts of at most partSize.
+func splitParts(size, partSize uint64) []transferPart {
+	var parts []transferPart
+	for offset := uint64(0); offset < size; offset += partSize {
+		partLen := partSize
+		if size-offset < partLen {
+			partLen = size - offset
+		}
+		parts = append(parts, transferPart{
+			number: uint32(len(parts) + 1),
+			offset: offset,
+			size:   partLen,
+		})
+	}
+	return parts
+}
+
+// runParts calls fn for every part from at most workers goroutines. The first
+// error cancels the parts still running and is returned.
+func runParts(ctx context.Context, workers int, parts []transferPart, fn func(context.Context, transferPart) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if workers < 1 {
+		workers = 1
+	}
+	work := make(chan transferPart)
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for part := range work {
+				if err := fn(ctx, part); err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+feed:
+	for _, part := range parts {
+		select {
+		case work <- part:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(work)
+	wg.Wait()
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
+
+// contextReader stops reading from r once ctx is done, so that long reads of
+// a file can be abandoned with the transfer they belong to.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (c *contextReader) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.r.Read(p)
+}
@@ -0,0 +1,315 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Experiment tracks a training run which produces models and checkpoints.
+type Experiment struct {
+	Id         string    `json:"id"`
+	Name       string    `json:"name"`
+	Namespace  string    `json:"namespace"`
+	Owner      string    `json:"owner"`
+	Framework  string    `json:"framework"`
+	ExternalId string    `json:"external_id"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// Model groups the versions of a model trained to solve a task.
+type Model struct {
+	Id          string    `json:"id"`
+	Name        string    `json:"name"`
+	Owner       string    `json:"owner"`
+	Namespace   string    `json:"namespace"`
+	Description string    `json:"description"`
+	Task        string    `json:"task"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// ModelVersion is a trained version of a Model.
+type ModelVersion struct {
+	Id          string    `json:"id"`
+	ModelId     string    `json:"model_id"`
+	Name        string    `json:"name"`
+	Version     string    `json:"version"`
+	Description string    `json:"description"`
+	Framework   string    `json:"framework"`
+	UniqueTags  []string  `json:"unique_tags"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// FileMetadata describes a file tracked or stored by ModelBox.
+type FileMetadata struct {
+	Id                string            `json:"id"`
+	ParentId          string            `json:"parent_id"`
+	FileType          FileType          `json:"file_type"`
+	Checksum          string            `json:"checksum"`
+	ChecksumAlgorithm ChecksumAlgorithm `json:"checksum_algorithm"`
+	SrcPath           string            `json:"src_path"`
+	UploadPath        string            `json:"upload_path"`
+	Size              uint64            `json:"size"`
+	CreatedAt         time.Time         `json:"created_at"`
+	UpdatedAt         time.Time         `json:"updated_at"`
+}
+
+// Artifact is a named set of files attached to an experiment, model or
+// model version.
+type Artifact struct {
+	Id       string          `json:"id"`
+	Name     string          `json:"name"`
+	ObjectId string          `json:"object_id"`
+	Files    []*FileMetadata `json:"files"`
+}
+
+// MetricValue is the value of a metric at a given step. Value holds a
+// float32 for scalars, a string for serialized tensors, or a []byte for
+// binary tensors.
+type MetricValue struct {
+	Step          uint64      `json:"step"`
+	WallclockTime uint64      `json:"wallclock_time"`
+	Value         interface{} `json:"value"`
+}
+
+// Event is something that happened to an experiment, model or model version,
+// as reported by a system interacting with it.
+type Event struct {
+	Name          string            `json:"name"`
+	Source        string            `json:"source"`
+	WallclockTime time.Time         `json:"wallclock_time"`
+	Metadata      map[string]string `json:"metadata"`
+}
+
+// SearchResult is an experiment matched by a search, with its metadata and
+// summaries of the metrics the query refers to.
+type SearchResult struct {
+	Experiment *Experiment               `json:"experiment"`
+	Metadata   map[string]interface{}    `json:"metadata"`
+	Metrics    map[string]*MetricSummary `json:"metrics"`
+}
+
+// MetricSummary holds the latest and best float values of a metric.
+type MetricSummary struct {
+	Latest float32 `json:"latest"`
+	// Step the latest value was logged at.
+	Step uint64  `json:"step"`
+	Min  float32 `json:"min"`
+	Max  float32 `json:"max"`
+}
+
+type CreateExperimentResponse struct {
+	Id        string    `json:"id"`
+	Exists    bool      `json:"exists"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type CreateModelVersionResponse struct {
+	Id        string    `json:"id"`
+	Exists    bool      `json:"exists"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type TrackArtifactsResponse struct {
+	Id string `json:"id"`
+}
+
+type LogEventResponse struct {
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func MLFrameworkStrFromProto(framework proto.MLFramework) string {
+	switch framework {
+	case proto.MLFramework_PYTORCH:
+		return "pytorch"
+	case proto.MLFramework_KERAS:
+		return "keras"
+	}
+	return "unknown"
+}
+
+func toTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+func experimentFromProto(e *proto.Experiment) *Experiment {
+	return &Experiment{
+		Id:         e.GetId(),
+		Name:       e.GetName(),
+		Namespace:  e.GetNamespace(),
+		Owner:      e.GetOwner(),
+		Framework:  MLFrameworkStrFromProto(e.GetFramework()),
+		ExternalId: e.GetExternalId(),
+		CreatedAt:  toTime(e.GetCreatedAt()),
+		UpdatedAt:  toTime(e.GetUpdatedAt()),
+	}
+}
+
+func modelFromProto(m *proto.Model) *Model {
+	return &Model{
+		Id:          m.GetId(),
+		Name:        m.GetName(),
+		Owner:       m.GetOwner(),
+		Namespace:   m.GetNamespace(),
+		Description: m.GetDescription(),
+		Task:        m.GetTask(),
+		CreatedAt:   toTime(m.GetCreatedAt()),
+		UpdatedAt:   toTime(m.GetUpdatedAt()),
+	}
+}
+
+func modelVersionFromProto(mv *proto.ModelVersion) *ModelVersion {
+	return &ModelVersion{
+		Id:          mv.GetId(),
+		ModelId:     mv.GetModelId(),
+		Name:        mv.GetName(),
+		Version:     mv.GetVersion(),
+		Description: mv.GetDescription(),
+		Framework:   MLFrameworkStrFromProto(mv.GetFramework()),
+		UniqueTags:  mv.GetUniqueTags(),
+		CreatedAt:   toTime(mv.GetCreatedAt()),
+		UpdatedAt:   toTime(mv.GetUpdatedAt()),
+	}
+}
+
+func fileMetadataFromProto(f *proto.FileMetadata) *FileMetadata {
+	return &FileMetadata{
+		Id:                f.GetId(),
+		ParentId:          f.GetParentId(),
+		FileType:          FileTypeFromProto(f.GetFileType()),
+		Checksum:          f.GetChecksum(),
+		ChecksumAlgorithm: ChecksumAlgorithmFromProto(f.GetChecksumAlgorithm()),
+		SrcPath:           f.GetSrcPath(),
+		UploadPath:        f.GetUploadPath(),
+		Size:              f.GetSize(),
+		CreatedAt:         toTime(f.GetCreatedAt()),
+		UpdatedAt:         toTime(f.GetUpdatedAt()),
+	}
+}
+
+func (f *FileMetadata) toProto() *proto.FileMetadata {
+	return &proto.FileMetadata{
+		Id:                f.Id,
+		ParentId:          f.ParentId,
+		FileType:          f.FileType.ToProto(),
+		Checksum:          f.Checksum,
+		ChecksumAlgorithm: f.ChecksumAlgorithm.ToProto(),
+		SrcPath:           f.SrcPath,
+		UploadPath:        f.UploadPath,
+		Size:              f.Size,
+	}
+}
+
+func artifactFromProto(a *proto.Artifact) *Artifact {
+	files := make([]*FileMetadata, 0, len(a.GetFiles()))
+	for _, f := range a.GetFiles() {
+		files = append(files, fileMetadataFromProto(f))
+	}
+	return &Artifact{
+		Id:       a.GetId(),
+		Name:     a.GetName(),
+		ObjectId: a.GetObjectId(),
+		Files:    files,
+	}
+}
+
+func metricValueFromProto(v *proto.MetricsValue) *MetricValue {
+	mv := &MetricValue{Step: v.GetStep(), WallclockTime: v.GetWallclockTime()}
+	switch val := v.GetValue().(type) {
+	case *proto.MetricsValue_FVal:
+		mv.Value = val.FVal
+	case *proto.MetricsValue_STensor:
+		mv.Value = val.STensor
+	case *proto.MetricsValue_BTensor:
+		mv.Value = val.BTensor
+	}
+	return mv
+}
+
+func (v *MetricValue) toProto() (*proto.MetricsValue, error) {
+	mv := &proto.MetricsValue{Step: v.Step, WallclockTime: v.WallclockTime}
+	switch val := v.Value.(type) {
+	case float32:
+		mv.Value = &proto.MetricsValue_FVal{FVal: val}
+	case float64:
+		mv.Value = &proto.MetricsValue_FVal{FVal: float32(val)}
+	case string:
+		mv.Value = &proto.MetricsValue_STensor{STensor: val}
+	case []byte:
+		mv.Value = &proto.MetricsValue_BTensor{BTensor: val}
+	default:
+		return nil, fmt.Errorf("%w: unsupported metric value of type %T", ErrInvalidArgument, v.Value)
+	}
+	return mv, nil
+}
+
+func searchResultFromProto(r *proto.SearchResult) *SearchResult {
+	metrics := make(map[string]*MetricSummary, len(r.GetMetrics()))
+	for key, m := range r.GetMetrics() {
+		metrics[key] = &MetricSummary{Latest: m.GetLatest(), Step: m.GetStep(), Min: m.GetMin(), Max: m.GetMax()}
+	}
+	return &SearchResult{
+		Experiment: experimentFromProto(r.GetExperiment()),
+		Metadata:   decodeMetadata(r.GetMetadata()),
+		Metrics:    metrics,
+	}
+}
+
+func eventFromProto(e *proto.Event) *Event {
+	return &Event{
+		Name:          e.GetName(),
+		Source:        e.GetSource().GetName(),
+		WallclockTime: toTime(e.GetWallclockTime()),
+		Metadata:      e.GetMetadata().GetMetadata(),
+	}
+}
+
+func (e *Event) toProto() *proto.Event {
+	ev := &proto.Event{
+		Name:     e.Name,
+		Source:   &proto.EventSource{Name: e.Source},
+		Metadata: &proto.Metadata{Metadata: e.Metadata},
+	}
+	if !e.WallclockTime.IsZero() {
+		ev.WallclockTime = timestamppb.New(e.WallclockTime)
+	}
+	return ev
+}
+
+// Metadata values are stored as JSON documents so that every SDK can read
+// the values written by the others.
+func encodeMetadata(metadata map[string]interface{}) (map[string]string, error) {
+	encoded := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		encoded[k] = string(b)
+	}
+	return encoded, nil
+}
+
+func decodeMetadata(metadata map[string]string) map[string]interface{} {
+	decoded := make(map[string]interface{}, len(metadata))
+	for k, v := range metadata {
+		var val interface{}
+		if err := json.Unmarshal([]byte(v), &val); err != nil {
+			decoded[k] = v
+			continue
+		}
+		decoded[k] = val
+	}
+	return decoded
+}
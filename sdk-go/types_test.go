@@ -0,0 +1,38 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetadataRoundTrip(t *testing.T) {
+	encoded, err := encodeMetadata(map[string]interface{}{
+		"lr":        0.001,
+		"optimizer": "adam",
+		"layers":    []int{64, 64},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, `"adam"`, encoded["optimizer"])
+
+	// Values written by other tools aren't always JSON
+	encoded["raw"] = "not json"
+	decoded := decodeMetadata(encoded)
+	assert.Equal(t, 0.001, decoded["lr"])
+	assert.Equal(t, "adam", decoded["optimizer"])
+	assert.Equal(t, []interface{}{64.0, 64.0}, decoded["layers"])
+	assert.Equal(t, "not json", decoded["raw"])
+}
+
+func TestMetricValueRoundTrip(t *testing.T) {
+	for _, v := range []interface{}{float32(0.5), "[1, 2]", []byte{1, 2}} {
+		mv := &MetricValue{Step: 10, WallclockTime: 1000, Value: v}
+		p, err := mv.toProto()
+		assert.Nil(t, err)
+		assert.Equal(t, mv, metricValueFromProto(p))
+	}
+	for _, v := range []interface{}{1, []float32{1, 2}, nil} {
+		_, err := (&MetricValue{Value: v}).toProto()
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	}
+}
@@ -0,0 +1,411 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"os"
+	"time"
+
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DEFAULT_UPLOAD_CHUNK_SIZE = 1 << 20
+	DEFAULT_UPLOAD_RESUMES    = 3
+)
+
+var crc32c = crc32.MakeTable(crc32.Castagnoli)
+
+// UploadInterruptedError is returned when an upload couldn't be finished. The
+// upload can be continued with ResumeUpload using UploadId.
+type UploadInterruptedError struct {
+	UploadId string
+	// Offset is the number of bytes known to be stored by the server.
+	Offset uint64
+	Err    error
+}
+
+func (e *UploadInterruptedError) Error() string {
+	return fmt.Sprintf("upload %v interrupted at offset %v: %v", e.UploadId, e.Offset, e.Err)
+}
+
+func (e *UploadInterruptedError) Unwrap() error {
+	return e.Err
+}
+
+func interruptedError(uploadId string, offset uint64, err error) *UploadInterruptedError {
+	return &UploadInterruptedError{UploadId: uploadId, Offset: offset, Err: wrapError("upload file", err)}
+}
+
+// UploadMetadata describes a file uploaded with UploadReader.
+type UploadMetadata struct {
+	ArtifactName string
+	ObjectId     string
+	FileType     FileType
+	// SrcPath names the file within the object it belongs to.
+	SrcPath string
+	// Size of the file if known. Readers implementing io.ReaderAt whose size
+	// is larger than the part size are uploaded in parts with
+	// WithParallelTransfers.
+	Size uint64
+	// UploadId continues the upload with this id when set, see
+	// UploadInterruptedError.
+	UploadId string
+}
+
+// UploadFile streams the file at path to ModelBox. The file is read once,
+// checksummed as it is sent, and if the stream drops the upload continues
+// from the last offset acknowledged by the server.
+func (m *ModelBoxClient) UploadFile(ctx context.Context, artifactName, objectId, path string, t FileType) (*FileUploadResponse, error) {
+	return m.uploadPath(ctx, "", artifactName, objectId, path, t)
+}
+
+// ResumeUpload continues an upload which failed with an UploadInterruptedError,
+// for instance after the process uploading the file restarted.
+func (m *ModelBoxClient) ResumeUpload(ctx context.Context, uploadId, artifactName, objectId, path string, t FileType) (*FileUploadResponse, error) {
+	return m.uploadPath(ctx, uploadId, artifactName, objectId, path, t)
+}
+
+func (m *ModelBoxClient) uploadPath(ctx context.Context, uploadId, artifactName, objectId, path string, t FileType) (*FileUploadResponse, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open file: %v", err)
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("unable to stat file: %v", err)
+	}
+	return m.UploadReader(ctx, f, &UploadMetadata{
+		ArtifactName: artifactName,
+		ObjectId:     objectId,
+		FileType:     t,
+		SrcPath:      path,
+		Size:         uint64(info.Size()),
+		UploadId:     uploadId,
+	})
+}
+
+// UploadReader streams the contents of r to ModelBox without touching the
+// local disk. Uploads from readers implementing io.ReaderAt, such as files,
+// resume from the last offset acknowledged by the server when the stream
+// drops. Other readers can only be resumed when everything sent before the
+// stream dropped was stored by the server.
+func (m *ModelBoxClient) UploadReader(ctx context.Context, r io.Reader, meta *UploadMetadata) (*FileUploadResponse, error) {
+	uploadId, resume := meta.UploadId, meta.UploadId != ""
+	if !resume {
+		var err error
+		if uploadId, err = newUploadId(); err != nil {
+			return nil, err
+		}
+	}
+	// Transfers aren't bounded by the default deadline, large files can take
+	// much longer than a unary call. Callers bound them through ctx.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	uploadMeta := &proto.UploadFileMetadata{
+		ArtifactName: meta.ArtifactName,
+		ObjectId:     meta.ObjectId,
+		Metadata: &proto.FileMetadata{
+			ParentId:          meta.ObjectId,
+			FileType:          meta.FileType.ToProto(),
+			ChecksumAlgorithm: m.opts.checksumAlgorithm.ToProto(),
+			SrcPath:           meta.SrcPath,
+			Size:              meta.Size,
+		},
+		UploadId: uploadId,
+	}
+	ra, ok := r.(io.ReaderAt)
+	if ok && m.opts.transferWorkers > 1 && meta.Size > m.opts.partSize {
+		return m.uploadParts(ctx, ra, meta.Size, uploadMeta, resume)
+	}
+	u := m.newFileUpload(uploadMeta)
+	if ok {
+		u.readerAt = ra
+	} else {
+		u.reader = r
+	}
+	resp, err := u.run(ctx, resume, m.opts.uploadResumes)
+	if err != nil {
+		return nil, err
+	}
+	return &FileUploadResponse{resp.FileId, resp.ArtifactId, u.checksum}, nil
+}
+
+// uploadParts uploads the parts of f concurrently, each as a resumable
+// upload, and asks the server to assemble them once they are all stored.
+func (m *ModelBoxClient) uploadParts(ctx context.Context, f io.ReaderAt, size uint64, meta *proto.UploadFileMetadata, resume bool) (*FileUploadResponse, error) {
+	// The checksum of the whole file is computed alongside the parts so the
+	// server can verify the assembled file. Hashing stops with the upload.
+	hashCtx, stopHash := context.WithCancel(ctx)
+	defer stopHash()
+	fileHash := m.opts.checksumAlgorithm.New()
+	hashed := make(chan error, 1)
+	go func() {
+		_, err := io.Copy(fileHash, &contextReader{hashCtx, io.NewSectionReader(f, 0, int64(size))})
+		hashed <- err
+	}()
+	parts := splitParts(size, m.opts.partSize)
+	uploaded := make([]*proto.UploadPart, len(parts))
+	err := runParts(ctx, m.opts.transferWorkers, parts, func(ctx context.Context, part transferPart) error {
+		partMeta := &proto.UploadFileMetadata{
+			ArtifactName: meta.ArtifactName,
+			ObjectId:     meta.ObjectId,
+			Metadata:     meta.Metadata,
+			UploadId:     meta.UploadId,
+			PartNumber:   part.number,
+		}
+		u := m.newFileUpload(partMeta)
+		u.readerAt = io.NewSectionReader(f, int64(part.offset), int64(part.size))
+		if _, err := u.run(ctx, resume, m.opts.uploadResumes); err != nil {
+			return err
+		}
+		uploaded[part.number-1] = &proto.UploadPart{
+			PartNumber: part.number,
+			Offset:     part.offset,
+			Size:       part.size,
+			Checksum:   u.checksum,
+		}
+		return nil
+	})
+	if err == nil {
+		if hashErr := <-hashed; hashErr != nil {
+			err = fmt.Errorf("unable to read file: %v", hashErr)
+		}
+	}
+	if err != nil {
+		var interrupted *UploadInterruptedError
+		if errors.As(err, &interrupted) {
+			// the offset of a single part says nothing about the file
+			return nil, interruptedError(meta.UploadId, 0, interrupted.Err)
+		}
+		return nil, interruptedError(meta.UploadId, 0, err)
+	}
+	checksum := hex.EncodeToString(fileHash.Sum(nil))
+	ctx, cancel := withDefaultDeadline(ctx, m.opts.timeout)
+	defer cancel()
+	resp, err := m.client.CompleteUpload(ctx, &proto.CompleteUploadRequest{
+		Metadata: meta,
+		Parts:    uploaded,
+		Size:     size,
+		Checksum: checksum,
+	})
+	if err != nil {
+		return nil, interruptedError(meta.UploadId, size, wrapError("complete upload", err))
+	}
+	return &FileUploadResponse{resp.FileId, resp.ArtifactId, checksum}, nil
+}
+
+func (m *ModelBoxClient) newFileUpload(meta *proto.UploadFileMetadata) *fileUpload {
+	return &fileUpload{
+		client:    m.client,
+		hash:      m.opts.checksumAlgorithm.New(),
+		meta:      meta,
+		chunkSize: m.opts.uploadChunkSize,
+	}
+}
+
+// fileUpload is a resumable upload of a file, or of a part of it, read from
+// readerAt or else from reader. hashed tracks how much of the file has been
+// written to hash so that the file is only read again when the server
+// acknowledged less than was sent.
+type fileUpload struct {
+	client    proto.ModelStoreClient
+	readerAt  io.ReaderAt
+	reader    io.Reader
+	hash      hash.Hash
+	hashed    uint64
+	meta      *proto.UploadFileMetadata
+	chunkSize int
+	checksum  string
+}
+
+// run sends the file, continuing from the offset committed by the server up
+// to resumes times when the stream fails. With resume the upload starts from
+// the offset committed by an earlier run.
+func (u *fileUpload) run(ctx context.Context, resume bool, resumes int) (*proto.UploadFileResponse, error) {
+	var offset uint64
+	if resume {
+		var err error
+		if offset, err = u.committedOffset(ctx); err != nil {
+			return nil, interruptedError(u.meta.UploadId, 0, err)
+		}
+	}
+	for attempt := 0; ; attempt++ {
+		resp, err := u.send(ctx, offset)
+		if err == nil {
+			return resp, nil
+		}
+		if attempt >= resumes || !isResumable(err) {
+			return nil, interruptedError(u.meta.UploadId, offset, err)
+		}
+		select {
+		case <-time.After(resumeBackoff(attempt)):
+		case <-ctx.Done():
+			return nil, interruptedError(u.meta.UploadId, offset, ctx.Err())
+		}
+		committed, qerr := u.committedOffset(ctx)
+		if qerr != nil {
+			return nil, interruptedError(u.meta.UploadId, offset, err)
+		}
+		offset = committed
+	}
+}
+
+func (u *fileUpload) metadataFrame() *proto.UploadFileRequest {
+	return &proto.UploadFileRequest{
+		StreamFrame: &proto.UploadFileRequest_Metadata{Metadata: u.meta},
+	}
+}
+
+// committedOffset asks the server how much of the upload it has stored.
+func (u *fileUpload) committedOffset(ctx context.Context) (uint64, error) {
+	stream, err := u.client.UploadFile(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err := stream.Send(u.metadataFrame()); err != nil && err != io.EOF {
+		return 0, err
+	}
+	resp, err := stream.CloseAndRecv()
+	if status.Code(err) == codes.NotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return resp.CommittedOffset, nil
+}
+
+// send streams the file from offset and commits it.
+func (u *fileUpload) send(ctx context.Context, offset uint64) (*proto.UploadFileResponse, error) {
+	if err := u.seek(offset); err != nil {
+		return nil, err
+	}
+	stream, err := u.client.UploadFile(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Send only reports io.EOF when the stream breaks, the cause is returned
+	// by CloseAndRecv.
+	sendFrame := func(req *proto.UploadFileRequest) error {
+		if err := stream.Send(req); err != nil {
+			if err == io.EOF {
+				_, err = stream.CloseAndRecv()
+			}
+			return err
+		}
+		return nil
+	}
+	if err := sendFrame(u.metadataFrame()); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, u.chunkSize)
+	for {
+		n, rerr := u.read(buf, offset)
+		if n > 0 {
+			data := buf[:n]
+			u.hash.Write(data)
+			u.hashed += uint64(n)
+			req := &proto.UploadFileRequest{
+				StreamFrame: &proto.UploadFileRequest_Chunk{Chunk: &proto.FileChunk{
+					Offset: offset,
+					Data:   data,
+					Crc32C: crc32.Checksum(data, crc32c),
+				}},
+			}
+			if err := sendFrame(req); err != nil {
+				return nil, err
+			}
+			offset += uint64(n)
+		}
+		if rerr == io.EOF {
+			break
+		}
+		if rerr != nil {
+			return nil, fmt.Errorf("unable to read file: %v", rerr)
+		}
+	}
+	u.checksum = hex.EncodeToString(u.hash.Sum(nil))
+	commit := &proto.UploadFileRequest{
+		StreamFrame: &proto.UploadFileRequest_Commit{Commit: &proto.UploadFileCommit{
+			Size:     offset,
+			Checksum: u.checksum,
+		}},
+	}
+	if err := sendFrame(commit); err != nil {
+		return nil, err
+	}
+	return stream.CloseAndRecv()
+}
+
+// seek prepares the upload to send the file from offset, hashing everything
+// before it. Readers can only move forward.
+func (u *fileUpload) seek(offset uint64) error {
+	if u.hashed == offset {
+		return nil
+	}
+	if u.readerAt == nil {
+		if offset < u.hashed {
+			return fmt.Errorf("unable to resume upload from offset %v, the reader is at %v", offset, u.hashed)
+		}
+		if _, err := io.CopyN(u.hash, u.reader, int64(offset-u.hashed)); err != nil {
+			return fmt.Errorf("unable to read file: %v", err)
+		}
+		u.hashed = offset
+		return nil
+	}
+	u.hash.Reset()
+	if _, err := io.Copy(u.hash, io.NewSectionReader(u.readerAt, 0, int64(offset))); err != nil {
+		return fmt.Errorf("unable to read file: %v", err)
+	}
+	u.hashed = offset
+	return nil
+}
+
+// read reads the next chunk of the file at offset, returning io.EOF once the
+// whole file has been read.
+func (u *fileUpload) read(buf []byte, offset uint64) (int, error) {
+	if u.readerAt != nil {
+		return u.readerAt.ReadAt(buf, int64(offset))
+	}
+	n, err := io.ReadFull(u.reader, buf)
+	if err == io.ErrUnexpectedEOF {
+		err = io.EOF
+	}
+	return n, err
+}
+
+// isResumable reports whether an upload failing with err may succeed when
+// continued.
+func isResumable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.Aborted, codes.Internal, codes.Unknown, codes.OutOfRange:
+		return true
+	}
+	return false
+}
+
+func resumeBackoff(attempt int) time.Duration {
+	backoff := 250 * time.Millisecond << attempt
+	if backoff > 5*time.Second {
+		return 5 * time.Second
+	}
+	return backoff
+}
+
+func newUploadId() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("unable to create upload id: %v", err)
+	}
+	return hex.EncodeToString(b), nil
+}
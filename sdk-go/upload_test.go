@@ -0,0 +1,252 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"lukechampine.com/blake3"
+)
+
+// uploadServer stores resumable uploads in memory and drops the first stream
+// after dropAfter bytes. Completed files are served by DownloadFile.
+type uploadServer struct {
+	proto.UnimplementedModelStoreServer
+
+	mu        sync.Mutex
+	uploads   map[string][]byte
+	checksums map[string]string
+	files     map[string][]byte
+	// fileChecksums is the algorithm DownloadFile checksums files with
+	fileChecksums map[string]ChecksumAlgorithm
+	// downloadOffsets records the offset of every DownloadFile request.
+	// legacyDownloads serves whole files without sizes or ranges and
+	// corruptDownloads serves files which don't match their checksum.
+	// corruptChunks serves chunks which don't match their CRC32C and
+	// misplacedChunks serves chunks at the wrong offset.
+	downloadOffsets  []uint64
+	legacyDownloads  bool
+	corruptDownloads bool
+	corruptChunks    bool
+	misplacedChunks  bool
+	dropAfter        int
+	streams          int
+}
+
+func newUploadServer(dropAfter int) *uploadServer {
+	return &uploadServer{
+		uploads:       map[string][]byte{},
+		checksums:     map[string]string{},
+		files:         map[string][]byte{},
+		fileChecksums: map[string]ChecksumAlgorithm{},
+		dropAfter:     dropAfter,
+	}
+}
+
+func uploadKey(meta *proto.UploadFileMetadata) string {
+	if meta.GetPartNumber() == 0 {
+		return meta.GetUploadId()
+	}
+	return fmt.Sprintf("%v/%v", meta.GetUploadId(), meta.GetPartNumber())
+}
+
+func (s *uploadServer) UploadFile(stream proto.ModelStore_UploadFileServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	uploadId := uploadKey(req.GetMetadata())
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.streams++
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&proto.UploadFileResponse{
+				UploadId:        uploadId,
+				CommittedOffset: uint64(len(s.uploads[uploadId])),
+			})
+		}
+		if err != nil {
+			return err
+		}
+		switch frame := req.StreamFrame.(type) {
+		case *proto.UploadFileRequest_Chunk:
+			chunk := frame.Chunk
+			if chunk.Offset != uint64(len(s.uploads[uploadId])) {
+				return status.Error(codes.OutOfRange, "unexpected offset")
+			}
+			if crc32.Checksum(chunk.Data, crc32c) != chunk.Crc32C {
+				return status.Error(codes.DataLoss, "corrupt chunk")
+			}
+			s.uploads[uploadId] = append(s.uploads[uploadId], chunk.Data...)
+			if s.dropAfter > 0 && len(s.uploads[uploadId]) >= s.dropAfter {
+				s.dropAfter = 0
+				return status.Error(codes.Unavailable, "connection reset")
+			}
+		case *proto.UploadFileRequest_Commit:
+			s.checksums[uploadId] = frame.Commit.Checksum
+			s.files["file-1"] = s.uploads[uploadId]
+			return stream.SendAndClose(&proto.UploadFileResponse{
+				FileId:          "file-1",
+				UploadId:        uploadId,
+				CommittedOffset: uint64(len(s.uploads[uploadId])),
+				Completed:       true,
+			})
+		}
+	}
+}
+
+func (s *uploadServer) CompleteUpload(ctx context.Context, req *proto.CompleteUploadRequest) (*proto.UploadFileResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var file []byte
+	for _, part := range req.Parts {
+		key := fmt.Sprintf("%v/%v", req.Metadata.UploadId, part.PartNumber)
+		if uint64(len(file)) != part.Offset || s.checksums[key] != part.Checksum {
+			return nil, status.Error(codes.InvalidArgument, "unexpected part")
+		}
+		file = append(file, s.uploads[key]...)
+	}
+	h := ChecksumAlgorithmFromProto(req.Metadata.Metadata.ChecksumAlgorithm).New()
+	h.Write(file)
+	if uint64(len(file)) != req.Size || hex.EncodeToString(h.Sum(nil)) != req.Checksum {
+		return nil, status.Error(codes.DataLoss, "checksum mismatch")
+	}
+	s.files["file-1"] = file
+	return &proto.UploadFileResponse{FileId: "file-1", UploadId: req.Metadata.UploadId, Completed: true}, nil
+}
+
+func newUploadTestClient(t *testing.T, server *uploadServer, opts ...ClientOption) *ModelBoxClient {
+	return newTestClient(t, server, opts...)
+}
+
+// newTestClient connects a client to server over an in-memory listener.
+func newTestClient(t *testing.T, server proto.ModelStoreServer, opts ...ClientOption) *ModelBoxClient {
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	proto.RegisterModelStoreServer(s, server)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+	dialer := func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}
+	opts = append(opts, WithDialOptions(grpc.WithContextDialer(dialer)))
+	client, err := NewModelBoxClient("bufnet", opts...)
+	assert.Nil(t, err)
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func writeTestFile(t *testing.T, size int) (string, []byte) {
+	data := bytes.Repeat([]byte("modelbox"), size/8)
+	path := filepath.Join(t.TempDir(), "model.pt")
+	assert.Nil(t, os.WriteFile(path, data, 0644))
+	return path, data
+}
+
+func TestUploadFileResumesAfterDisconnect(t *testing.T) {
+	server := newUploadServer(3 * 1024)
+	client := newUploadTestClient(t, server, WithUploadChunkSize(1024))
+	path, data := writeTestFile(t, 8*1024)
+
+	resp, err := client.UploadFile(context.Background(), "model", "exp-1", path, FileTypeModel)
+	assert.Nil(t, err)
+	sum := sha256.Sum256(data)
+	assert.Equal(t, hex.EncodeToString(sum[:]), resp.Checksum)
+	assert.Equal(t, "file-1", resp.Id)
+	// the failed stream, the offset query and the resumed stream
+	assert.Equal(t, 3, server.streams)
+	for id, upload := range server.uploads {
+		assert.Equal(t, data, upload)
+		assert.Equal(t, resp.Checksum, server.checksums[id])
+	}
+}
+
+func TestUploadFileWithoutResumes(t *testing.T) {
+	server := newUploadServer(1024)
+	client := newUploadTestClient(t, server, WithUploadChunkSize(512), WithUploadResumes(0))
+	path, data := writeTestFile(t, 4*1024)
+
+	_, err := client.UploadFile(context.Background(), "model", "exp-1", path, FileTypeModel)
+	var interrupted *UploadInterruptedError
+	assert.ErrorAs(t, err, &interrupted)
+	assert.Equal(t, codes.Unavailable, status.Code(interrupted.Err))
+	assert.ErrorIs(t, err, ErrUnavailable)
+
+	resp, err := client.ResumeUpload(context.Background(), interrupted.UploadId, "model", "exp-1", path, FileTypeModel)
+	assert.Nil(t, err)
+	assert.Equal(t, data, server.uploads[interrupted.UploadId])
+	assert.Equal(t, resp.Checksum, server.checksums[interrupted.UploadId])
+}
+
+func TestUploadFileInParts(t *testing.T) {
+	server := newUploadServer(5 * 1024)
+	client := newUploadTestClient(t, server, WithUploadChunkSize(1024), WithParallelTransfers(3, 4*1024),
+		WithChecksumAlgorithm(ChecksumBLAKE3))
+	path, data := writeTestFile(t, 18*1024)
+
+	resp, err := client.UploadFile(context.Background(), "model", "exp-1", path, FileTypeModel)
+	assert.Nil(t, err)
+	sum := blake3.Sum256(data)
+	assert.Equal(t, hex.EncodeToString(sum[:]), resp.Checksum)
+	assert.Equal(t, data, server.files[resp.Id])
+}
+
+func TestSplitParts(t *testing.T) {
+	parts := splitParts(10, 4)
+	assert.Equal(t, []transferPart{
+		{number: 1, offset: 0, size: 4},
+		{number: 2, offset: 4, size: 4},
+		{number: 3, offset: 8, size: 2},
+	}, parts)
+	assert.Nil(t, splitParts(0, 4))
+}
+
+func TestContextReader(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &contextReader{ctx, bytes.NewReader([]byte("modelbox"))}
+	buf := make([]byte, 4)
+	n, err := r.Read(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, n)
+
+	cancel()
+	n, err = r.Read(buf)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 0, n)
+}
+
+func TestUploadReader(t *testing.T) {
+	server := newUploadServer(0)
+	client := newUploadTestClient(t, server, WithUploadChunkSize(1000))
+	data := bytes.Repeat([]byte("modelbox"), 1024)
+
+	// hide the io.ReaderAt of bytes.Reader to upload from a plain stream
+	r := struct{ io.Reader }{bytes.NewReader(data)}
+	resp, err := client.UploadReader(context.Background(), r, &UploadMetadata{
+		ArtifactName: "model",
+		ObjectId:     "exp-1",
+		FileType:     FileTypeModel,
+		SrcPath:      "model.pt",
+	})
+	assert.Nil(t, err)
+	sum := sha256.Sum256(data)
+	assert.Equal(t, hex.EncodeToString(sum[:]), resp.Checksum)
+	assert.Equal(t, data, server.files[resp.Id])
+}
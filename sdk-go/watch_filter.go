@@ -0,0 +1,86 @@
+package client
+
+import (
+	"path"
+
+	"github.com/tensorland/modelbox/sdk-go/proto"
+)
+
+// watchFilter selects the events delivered by a Watcher. It is sent to the
+// server with every watch, and also applied to the events received since
+// servers which predate filters send every event of the namespace.
+type watchFilter struct {
+	kinds     []ObjectKind
+	types     []ChangeEventType
+	owner     string
+	framework string
+	nameGlob  string
+}
+
+func (f *watchFilter) apply(req *proto.WatchNamespaceRequest) {
+	for _, kind := range f.kinds {
+		req.ObjectKinds = append(req.ObjectKinds, kind.ToProto())
+	}
+	for _, t := range f.types {
+		req.Events = append(req.Events, t.ToProto())
+	}
+	req.Owner = f.owner
+	if f.framework != "" {
+		req.MlFramework = MLFrameworkProtoFromStr(f.framework)
+	}
+	req.NameGlob = f.nameGlob
+}
+
+// matches reports whether event passes the filter. Fields which the object
+// of the event doesn't have, like the owner of a model version, are left to
+// the server to check.
+func (f *watchFilter) matches(event *ChangeEvent) bool {
+	if len(f.kinds) > 0 && !containsKind(f.kinds, event.Kind) {
+		return false
+	}
+	if len(f.types) > 0 && !containsType(f.types, event.Type) {
+		return false
+	}
+	var name, owner, framework string
+	switch o := event.Object.(type) {
+	case *Experiment:
+		name, owner, framework = o.Name, o.Owner, o.Framework
+	case *Model:
+		name, owner = o.Name, o.Owner
+	case *ModelVersion:
+		name, framework = o.Name, o.Framework
+	case *Artifact:
+		name = o.Name
+	}
+	if f.owner != "" && owner != "" && owner != f.owner {
+		return false
+	}
+	if f.framework != "" && framework != "" &&
+		MLFrameworkProtoFromStr(framework) != MLFrameworkProtoFromStr(f.framework) {
+		return false
+	}
+	if f.nameGlob != "" && name != "" {
+		if ok, _ := path.Match(f.nameGlob, name); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func containsKind(kinds []ObjectKind, kind ObjectKind) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func containsType(types []ChangeEventType, t ChangeEventType) bool {
+	for _, et := range types {
+		if et == t {
+			return true
+		}
+	}
+	return false
+}
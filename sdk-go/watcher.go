@@ -0,0 +1,273 @@
+package client
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DEFAULT_WATCH_BACKOFF     = 250 * time.Millisecond
+	DEFAULT_WATCH_MAX_BACKOFF = 30 * time.Second
+
+	// WATCH_MAX_REPEATED_FAILURES bounds the reconnects of a watch failing
+	// again and again with the same error, which is unlikely to go away by
+	// itself. Unavailable servers are waited for indefinitely.
+	WATCH_MAX_REPEATED_FAILURES = 5
+)
+
+type watchOptions struct {
+	since      time.Time
+	position   uint64
+	backoff    time.Duration
+	maxBackoff time.Duration
+	filter     watchFilter
+}
+
+type WatchOption func(*watchOptions)
+
+// WatchSince starts the watch with the events which happened after t instead
+// of the time the watch was started.
+func WatchSince(t time.Time) WatchOption {
+	return func(o *watchOptions) {
+		o.since = t
+	}
+}
+
+// WatchAfterPosition starts the watch with the events which follow the event
+// at position, for instance the last event processed by a previous watch.
+func WatchAfterPosition(position uint64) WatchOption {
+	return func(o *watchOptions) {
+		o.position = position
+	}
+}
+
+// WatchObjectKinds only delivers the changes of objects of the given kinds.
+func WatchObjectKinds(kinds ...ObjectKind) WatchOption {
+	return func(o *watchOptions) {
+		o.filter.kinds = append(o.filter.kinds, kinds...)
+	}
+}
+
+// WatchEventTypes only delivers the given types of changes, e.g.
+// ChangeEventObjectCreated to be notified of new objects only.
+func WatchEventTypes(types ...ChangeEventType) WatchOption {
+	return func(o *watchOptions) {
+		o.filter.types = append(o.filter.types, types...)
+	}
+}
+
+// WatchOwner only delivers the changes of objects owned by owner.
+func WatchOwner(owner string) WatchOption {
+	return func(o *watchOptions) {
+		o.filter.owner = owner
+	}
+}
+
+// WatchFramework only delivers the changes of experiments and model versions
+// using the given ML framework, e.g. "pytorch".
+func WatchFramework(framework string) WatchOption {
+	return func(o *watchOptions) {
+		o.filter.framework = framework
+	}
+}
+
+// WatchNameGlob only delivers the changes of objects whose name matches the
+// shell pattern glob, as understood by path.Match.
+func WatchNameGlob(glob string) WatchOption {
+	return func(o *watchOptions) {
+		o.filter.nameGlob = glob
+	}
+}
+
+// WatchBackoff sets the delay before the first reconnect of an interrupted
+// watch, the delay doubles with every failed attempt up to max.
+func WatchBackoff(initial, max time.Duration) WatchOption {
+	return func(o *watchOptions) {
+		o.backoff = initial
+		o.maxBackoff = max
+	}
+}
+
+// Watcher consumes the change stream of a namespace. Interrupted streams are
+// reconnected with backoff and resumed after the last event received, so that
+// events are neither lost nor repeated. A watch stops once the stream fails
+// WATCH_MAX_REPEATED_FAILURES times in a row with the same error without
+// receiving any event, unless the server is unavailable.
+type Watcher struct {
+	client    *ModelBoxClient
+	namespace string
+	opts      watchOptions
+	events    chan *ChangeEvent
+	cancel    context.CancelFunc
+
+	mu       sync.Mutex
+	position uint64
+	err      error
+}
+
+// Watch starts watching the changes of a namespace. Events are delivered on
+// Events until ctx is canceled, Close is called or the server rejects the
+// watch, after which Err reports why the watch stopped.
+func (m *ModelBoxClient) Watch(ctx context.Context, namespace string, opts ...WatchOption) *Watcher {
+	o := watchOptions{
+		since:      time.Now(),
+		backoff:    DEFAULT_WATCH_BACKOFF,
+		maxBackoff: DEFAULT_WATCH_MAX_BACKOFF,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	w := &Watcher{
+		client:    m,
+		namespace: m.opts.namespaceOrDefault(namespace),
+		opts:      o,
+		events:    make(chan *ChangeEvent),
+		cancel:    cancel,
+		position:  o.position,
+	}
+	go w.run(ctx)
+	return w
+}
+
+// Events returns the channel events are delivered on, it is closed when the
+// watch stops.
+func (w *Watcher) Events() <-chan *ChangeEvent {
+	return w.events
+}
+
+// Position returns the position of the last event received, including the
+// events dropped by filters.
+func (w *Watcher) Position() uint64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.position
+}
+
+// Err returns the error which stopped the watch once Events is closed, it is
+// nil if the watch was stopped by Close.
+func (w *Watcher) Err() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.err
+}
+
+// Close stops the watch.
+func (w *Watcher) Close() {
+	w.cancel()
+}
+
+func (w *Watcher) run(ctx context.Context) {
+	defer close(w.events)
+	backoff := w.opts.backoff
+	var (
+		lastCode codes.Code
+		failures int
+	)
+	for {
+		received, err := w.watch(ctx)
+		if ctx.Err() != nil {
+			w.stop(ctx.Err())
+			return
+		}
+		if err != nil && !isWatchRetryable(err) {
+			w.stop(err)
+			return
+		}
+		// a stream which made progress was healthy, start backing off anew
+		if received {
+			backoff = w.opts.backoff
+		}
+		code := status.Code(err)
+		if received || err == nil || code == codes.Unavailable || code != lastCode {
+			failures = 0
+		}
+		lastCode = code
+		if err != nil && code != codes.Unavailable {
+			if failures++; failures >= WATCH_MAX_REPEATED_FAILURES {
+				w.stop(err)
+				return
+			}
+		}
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			w.stop(ctx.Err())
+			return
+		}
+		if backoff *= 2; backoff > w.opts.maxBackoff {
+			backoff = w.opts.maxBackoff
+		}
+	}
+}
+
+// watch delivers the events of a single stream until it ends, and reports
+// whether any event was received.
+func (w *Watcher) watch(ctx context.Context) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	req := &proto.WatchNamespaceRequest{
+		Namespace:     w.namespace,
+		Since:         uint64(w.opts.since.Unix()),
+		AfterPosition: w.Position(),
+	}
+	w.opts.filter.apply(req)
+	stream, err := w.client.client.WatchNamespace(ctx, req)
+	if err != nil {
+		return false, err
+	}
+	received := false
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			// the server ended the stream, a new one picks up from here
+			return received, nil
+		}
+		if err != nil {
+			return received, err
+		}
+		event := &ChangeEvent{
+			Type:     changeEventTypeFromProto(resp.Event),
+			Kind:     objectKindFromProto(resp.ObjectKind),
+			ObjectId: resp.ObjectId,
+			Position: resp.Position,
+			Payload:  resp.Payload,
+		}
+		event.Object, event.DecodeErr = decodeChangePayload(event.Kind, resp.Payload)
+		received = true
+		if w.opts.filter.matches(event) {
+			select {
+			case w.events <- event:
+			case <-ctx.Done():
+				return received, ctx.Err()
+			}
+		}
+		w.mu.Lock()
+		w.position = resp.Position
+		w.mu.Unlock()
+	}
+}
+
+func (w *Watcher) stop(err error) {
+	if err == context.Canceled {
+		err = nil
+	}
+	w.mu.Lock()
+	w.err = wrapError("watch namespace", err)
+	w.mu.Unlock()
+}
+
+func isWatchRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.NotFound, codes.PermissionDenied,
+		codes.Unauthenticated, codes.Unimplemented, codes.FailedPrecondition:
+		return false
+	}
+	return true
+}
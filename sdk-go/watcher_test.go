@@ -0,0 +1,186 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// watchServer serves events from a fixed log, the first stream is dropped
+// after dropAfter events.
+type watchServer struct {
+	proto.UnimplementedModelStoreServer
+
+	mu        sync.Mutex
+	events    []*proto.WatchNamespaceResponse
+	dropAfter int
+	requests  []*proto.WatchNamespaceRequest
+}
+
+func (s *watchServer) WatchNamespace(req *proto.WatchNamespaceRequest, stream proto.ModelStore_WatchNamespaceServer) error {
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	dropAfter := s.dropAfter
+	s.dropAfter = 0
+	s.mu.Unlock()
+	switch req.Namespace {
+	case "forbidden":
+		return status.Error(codes.PermissionDenied, "not allowed")
+	case "broken":
+		return status.Error(codes.Internal, "corrupt change log")
+	}
+	sent := 0
+	for _, event := range s.events {
+		if event.Position <= req.AfterPosition {
+			continue
+		}
+		if dropAfter > 0 && sent == dropAfter {
+			return status.Error(codes.Unavailable, "connection reset")
+		}
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+		sent++
+	}
+	<-stream.Context().Done()
+	return nil
+}
+
+func newWatchServer(n int) *watchServer {
+	s := &watchServer{}
+	for i := 1; i <= n; i++ {
+		event := proto.ChangeEvent_OBJECT_UPDATED
+		if i%2 == 1 {
+			event = proto.ChangeEvent_OBJECT_CREATED
+		}
+		s.events = append(s.events, &proto.WatchNamespaceResponse{
+			Event:      event,
+			ObjectKind: proto.ObjectKind_OBJECT_KIND_MODEL,
+			ObjectId:   fmt.Sprintf("model-%v", i),
+			Payload: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+				"id": structpb.NewStringValue(fmt.Sprintf("model-%v", i)),
+			}}),
+			Position: uint64(i),
+		})
+	}
+	return s
+}
+
+func TestWatcherResumesAfterDisconnect(t *testing.T) {
+	server := newWatchServer(5)
+	server.dropAfter = 2
+	client := newTestClient(t, server)
+
+	w := client.Watch(context.Background(), "ns", WatchBackoff(time.Millisecond, 10*time.Millisecond))
+	defer w.Close()
+	for i := 1; i <= 5; i++ {
+		event := <-w.Events()
+		assert.Equal(t, uint64(i), event.Position)
+		assert.Equal(t, ObjectKindModel, event.Kind)
+		assert.Nil(t, event.DecodeErr)
+		assert.Equal(t, &Model{Id: fmt.Sprintf("model-%v", i)}, event.Object)
+		if i%2 == 1 {
+			assert.Equal(t, ChangeEventObjectCreated, event.Type)
+		} else {
+			assert.Equal(t, ChangeEventObjectUpdated, event.Type)
+		}
+	}
+	assert.Equal(t, uint64(5), w.Position())
+
+	w.Close()
+	_, ok := <-w.Events()
+	assert.False(t, ok)
+	assert.Nil(t, w.Err())
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	assert.Len(t, server.requests, 2)
+	assert.Equal(t, uint64(0), server.requests[0].AfterPosition)
+	assert.Equal(t, uint64(2), server.requests[1].AfterPosition)
+	assert.Equal(t, server.requests[0].Since, server.requests[1].Since)
+}
+
+func TestWatcherAfterPosition(t *testing.T) {
+	server := newWatchServer(3)
+	client := newTestClient(t, server)
+
+	w := client.Watch(context.Background(), "ns", WatchAfterPosition(2))
+	defer w.Close()
+	event := <-w.Events()
+	assert.Equal(t, uint64(3), event.Position)
+}
+
+func TestWatcherStopsOnPermanentError(t *testing.T) {
+	client := newTestClient(t, newWatchServer(1))
+
+	w := client.Watch(context.Background(), "forbidden")
+	_, ok := <-w.Events()
+	assert.False(t, ok)
+	assert.Equal(t, codes.PermissionDenied, status.Code(w.Err()))
+}
+
+func TestWatcherStopsOnRepeatedFailures(t *testing.T) {
+	server := newWatchServer(1)
+	client := newTestClient(t, server)
+
+	w := client.Watch(context.Background(), "broken", WatchBackoff(time.Millisecond, time.Millisecond))
+	_, ok := <-w.Events()
+	assert.False(t, ok)
+	assert.Equal(t, codes.Internal, status.Code(w.Err()))
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	assert.Len(t, server.requests, WATCH_MAX_REPEATED_FAILURES)
+}
+
+func experimentEvent(position uint64, name, owner string, framework proto.MLFramework) *proto.WatchNamespaceResponse {
+	return &proto.WatchNamespaceResponse{
+		Event:      proto.ChangeEvent_OBJECT_CREATED,
+		ObjectKind: proto.ObjectKind_OBJECT_KIND_EXPERIMENT,
+		Payload: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+			"name":         structpb.NewStringValue(name),
+			"owner":        structpb.NewStringValue(owner),
+			"ml_framework": structpb.NewNumberValue(float64(framework)),
+		}}),
+		Position: position,
+	}
+}
+
+func TestWatcherFilters(t *testing.T) {
+	// the server ignores filters, like servers which predate them
+	server := &watchServer{events: []*proto.WatchNamespaceResponse{
+		experimentEvent(1, "bert-base", "team-x", proto.MLFramework_KERAS),
+		experimentEvent(2, "bert-base", "team-y", proto.MLFramework_PYTORCH),
+		experimentEvent(3, "gpt", "team-x", proto.MLFramework_PYTORCH),
+		{Event: proto.ChangeEvent_OBJECT_CREATED, ObjectKind: proto.ObjectKind_OBJECT_KIND_METADATA, Position: 4},
+		experimentEvent(5, "bert-large", "team-x", proto.MLFramework_PYTORCH),
+	}}
+	client := newTestClient(t, server)
+
+	w := client.Watch(context.Background(), "ns",
+		WatchObjectKinds(ObjectKindExperiment),
+		WatchEventTypes(ChangeEventObjectCreated),
+		WatchOwner("team-x"),
+		WatchFramework("pytorch"),
+		WatchNameGlob("bert-*"),
+	)
+	defer w.Close()
+	event := <-w.Events()
+	assert.Equal(t, uint64(5), event.Position)
+	assert.Equal(t, "bert-large", event.Object.(*Experiment).Name)
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	req := server.requests[0]
+	assert.Equal(t, []proto.ObjectKind{proto.ObjectKind_OBJECT_KIND_EXPERIMENT}, req.ObjectKinds)
+	assert.Equal(t, []proto.ChangeEvent{proto.ChangeEvent_OBJECT_CREATED}, req.Events)
+	assert.Equal(t, "team-x", req.Owner)
+	assert.Equal(t, proto.MLFramework_PYTORCH, req.MlFramework)
+	assert.Equal(t, "bert-*", req.NameGlob)
+}
@@ -2,6 +2,7 @@
 # Generated by the protocol buffer compiler.  DO NOT EDIT!
 # source: admin.proto
 """Generated protocol buffer code."""
+from google.protobuf.internal import enum_type_wrapper
 from google.protobuf import descriptor as _descriptor
 from google.protobuf import descriptor_pool as _descriptor_pool
 from google.protobuf import message as _message
@@ -16,8 +17,21 @@ from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__
 from google.protobuf import struct_pb2 as google_dot_protobuf_dot_struct__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x0b\x61\x64min.proto\x12\x08modelbox\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\"\x1a\n\x18GetClusterMembersRequest\"S\n\rClusterMember\x12\n\n\x02id\x18\x01 \x01(\t\x12\x11\n\thost_name\x18\x02 \x01(\t\x12\x10\n\x08rpc_addr\x18\x03 \x01(\t\x12\x11\n\thttp_addr\x18\x04 \x01(\t\"E\n\x19GetClusterMembersResponse\x12(\n\x07members\x18\x01 \x03(\x0b\x32\x17.modelbox.ClusterMember\"<\n\x08NodeInfo\x12\x11\n\thost_name\x18\x01 \x01(\t\x12\x0f\n\x07ip_addr\x18\x02 \x01(\t\x12\x0c\n\x04\x61rch\x18\x03 \x01(\t\"K\n\x10HeartbeatRequest\x12\x0f\n\x07node_id\x18\x01 \x01(\t\x12&\n\x02\x61t\x18\x14 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\x13\n\x11HeartbeatResponse\"`\n\x15SubscribeEventRequest\x12\x11\n\tnamespace\x18\x01 \x01(\t\x12\x14\n\x0cml_framework\x18\x02 \x01(\t\x12\r\n\x05owner\x18\x03 \x01(\t\x12\x0f\n\x07\x61\x63tions\x18\x04 \x03(\t\"Q\n\x14RegisterAgentRequest\x12%\n\tnode_info\x18\x01 \x01(\x0b\x32\x12.modelbox.NodeInfo\x12\x12\n\nagent_name\x18\x02 \x01(\t\"(\n\x15RegisterAgentResponse\x12\x0f\n\x07node_id\x18\x01 \x01(\t\"F\n!GetRunnableActionInstancesRequest\x12\x13\n\x0b\x61\x63tion_name\x18\x01 \x01(\t\x12\x0c\n\x04\x61rch\x18\x02 \x01(\t\"\xbd\x01\n\x0eRunnableAction\x12\n\n\x02id\x18\x01 \x01(\t\x12\x11\n\taction_id\x18\x02 \x01(\t\x12\x0f\n\x07\x63ommand\x18\x03 \x01(\t\x12\x34\n\x06params\x18\x05 \x03(\x0b\x32$.modelbox.RunnableAction.ParamsEntry\x1a\x45\n\x0bParamsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12%\n\x05value\x18\x02 \x01(\x0b\x32\x16.google.protobuf.Value:\x02\x38\x01\"Q\n\"GetRunnableActionInstancesResponse\x12+\n\tinstances\x18\x01 \x03(\x0b\x32\x18.modelbox.RunnableAction\"\x85\x01\n\x19UpdateActionStatusRequest\x12\x1a\n\x12\x61\x63tion_instance_id\x18\x01 \x01(\t\x12\x0e\n\x06status\x18\x02 \x01(\r\x12\x0f\n\x07outcome\x18\x03 \x01(\r\x12\x16\n\x0eoutcome_reason\x18\x04 \x01(\t\x12\x13\n\x0budpate_time\x18\x05 \x01(\x04\"\x1c\n\x1aUpdateActionStatusResponse2\xdf\x03\n\rModelBoxAdmin\x12P\n\rRegisterAgent\x12\x1e.modelbox.RegisterAgentRequest\x1a\x1f.modelbox.RegisterAgentResponse\x12\x44\n\tHeartbeat\x12\x1a.modelbox.HeartbeatRequest\x1a\x1b.modelbox.HeartbeatResponse\x12w\n\x1aGetRunnableActionInstances\x12+.modelbox.GetRunnableActionInstancesRequest\x1a,.modelbox.GetRunnableActionInstancesResponse\x12_\n\x12UpdateActionStatus\x12#.modelbox.UpdateActionStatusRequest\x1a$.modelbox.UpdateActionStatusResponse\x12\\\n\x11GetClusterMembers\x12\".modelbox.GetClusterMembersRequest\x1a#.modelbox.GetClusterMembersResponseB-Z+github.com/tensorland/modelbox/sdk-go/protob\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x0b\x61\x64min.proto\x12\x08modelbox\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\"\x1a\n\x18GetClusterMembersRequest\"S\n\rClusterMember\x12\n\n\x02id\x18\x01 \x01(\t\x12\x11\n\thost_name\x18\x02 \x01(\t\x12\x10\n\x08rpc_addr\x18\x03 \x01(\t\x12\x11\n\thttp_addr\x18\x04 \x01(\t\"E\n\x19GetClusterMembersResponse\x12(\n\x07members\x18\x01 \x03(\x0b\x32\x17.modelbox.ClusterMember\"<\n\x08NodeInfo\x12\x11\n\thost_name\x18\x01 \x01(\t\x12\x0f\n\x07ip_addr\x18\x02 \x01(\t\x12\x0c\n\x04\x61rch\x18\x03 \x01(\t\"K\n\x10HeartbeatRequest\x12\x0f\n\x07node_id\x18\x01 \x01(\t\x12&\n\x02\x61t\x18\x14 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\x13\n\x11HeartbeatResponse\"`\n\x15SubscribeEventRequest\x12\x11\n\tnamespace\x18\x01 \x01(\t\x12\x14\n\x0cml_framework\x18\x02 \x01(\t\x12\r\n\x05owner\x18\x03 \x01(\t\x12\x0f\n\x07\x61\x63tions\x18\x04 \x03(\t\"Q\n\x14RegisterAgentRequest\x12%\n\tnode_info\x18\x01 \x01(\x0b\x32\x12.modelbox.NodeInfo\x12\x12\n\nagent_name\x18\x02 \x01(\t\"(\n\x15RegisterAgentResponse\x12\x0f\n\x07node_id\x18\x01 \x01(\t\"F\n!GetRunnableActionInstancesRequest\x12\x13\n\x0b\x61\x63tion_name\x18\x01 \x01(\t\x12\x0c\n\x04\x61rch\x18\x02 \x01(\t\"\xd0\x01\n\x0eRunnableAction\x12\n\n\x02id\x18\x01 \x01(\t\x12\x11\n\taction_id\x18\x02 \x01(\t\x12\x0f\n\x07\x63ommand\x18\x03 \x01(\t\x12\x34\n\x06params\x18\x05 \x03(\x0b\x32$.modelbox.RunnableAction.ParamsEntry\x12\x11\n\tobject_id\x18\x06 \x01(\t\x1a\x45\n\x0bParamsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12%\n\x05value\x18\x02 \x01(\x0b\x32\x16.google.protobuf.Value:\x02\x38\x01\"Q\n\"GetRunnableActionInstancesResponse\x12+\n\tinstances\x18\x01 \x03(\x0b\x32\x18.modelbox.RunnableAction\"\xe1\x01\n\x19UpdateActionStatusRequest\x12\x1a\n\x12\x61\x63tion_instance_id\x18\x01 \x01(\t\x12&\n\x06status\x18\x02 \x01(\x0e\x32\x16.modelbox.ActionStatus\x12(\n\x07outcome\x18\x03 \x01(\x0e\x32\x17.modelbox.ActionOutcome\x12\x16\n\x0eoutcome_reason\x18\x04 \x01(\t\x12\x17\n\x0budpate_time\x18\x05 \x01(\x04\x42\x02\x18\x01\x12\x13\n\x0bupdate_time\x18\x06 \x01(\x04\x12\x10\n\x08progress\x18\x07 \x01(\r\"\x1c\n\x1aUpdateActionStatusResponse*\xb3\x01\n\x0c\x41\x63tionStatus\x12\x19\n\x15\x41\x43TION_STATUS_UNKNOWN\x10\x00\x12\x19\n\x15\x41\x43TION_STATUS_PENDING\x10\x01\x12\x19\n\x15\x41\x43TION_STATUS_RUNNING\x10\x02\x12\x1b\n\x17\x41\x43TION_STATUS_SUCCEEDED\x10\x03\x12\x18\n\x14\x41\x43TION_STATUS_FAILED\x10\x04\x12\x1b\n\x17\x41\x43TION_STATUS_CANCELLED\x10\x05*c\n\rActionOutcome\x12\x1a\n\x16\x41\x43TION_OUTCOME_UNKNOWN\x10\x00\x12\x1a\n\x16\x41\x43TION_OUTCOME_SUCCESS\x10\x01\x12\x1a\n\x16\x41\x43TION_OUTCOME_FAILURE\x10\x02\x32\xdf\x03\n\rModelBoxAdmin\x12P\n\rRegisterAgent\x12\x1e.modelbox.RegisterAgentRequest\x1a\x1f.modelbox.RegisterAgentResponse\x12\x44\n\tHeartbeat\x12\x1a.modelbox.HeartbeatRequest\x1a\x1b.modelbox.HeartbeatResponse\x12w\n\x1aGetRunnableActionInstances\x12+.modelbox.GetRunnableActionInstancesRequest\x1a,.modelbox.GetRunnableActionInstancesResponse\x12_\n\x12UpdateActionStatus\x12#.modelbox.UpdateActionStatusRequest\x1a$.modelbox.UpdateActionStatusResponse\x12\\\n\x11GetClusterMembers\x12\".modelbox.GetClusterMembersRequest\x1a#.modelbox.GetClusterMembersResponseB-Z+github.com/tensorland/modelbox/sdk-go/protob\x06proto3')
 
+_ACTIONSTATUS = DESCRIPTOR.enum_types_by_name['ActionStatus']
+ActionStatus = enum_type_wrapper.EnumTypeWrapper(_ACTIONSTATUS)
+_ACTIONOUTCOME = DESCRIPTOR.enum_types_by_name['ActionOutcome']
+ActionOutcome = enum_type_wrapper.EnumTypeWrapper(_ACTIONOUTCOME)
+ACTION_STATUS_UNKNOWN = 0
+ACTION_STATUS_PENDING = 1
+ACTION_STATUS_RUNNING = 2
+ACTION_STATUS_SUCCEEDED = 3
+ACTION_STATUS_FAILED = 4
+ACTION_STATUS_CANCELLED = 5
+ACTION_OUTCOME_UNKNOWN = 0
+ACTION_OUTCOME_SUCCESS = 1
+ACTION_OUTCOME_FAILURE = 2
 
 
 _GETCLUSTERMEMBERSREQUEST = DESCRIPTOR.message_types_by_name['GetClusterMembersRequest']
@@ -148,6 +162,12 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   DESCRIPTOR._serialized_options = b'Z+github.com/tensorland/modelbox/sdk-go/proto'
   _RUNNABLEACTION_PARAMSENTRY._options = None
   _RUNNABLEACTION_PARAMSENTRY._serialized_options = b'8\001'
+  _UPDATEACTIONSTATUSREQUEST.fields_by_name['udpate_time']._options = None
+  _UPDATEACTIONSTATUSREQUEST.fields_by_name['udpate_time']._serialized_options = b'\030\001'
+  _ACTIONSTATUS._serialized_start=1280
+  _ACTIONSTATUS._serialized_end=1459
+  _ACTIONOUTCOME._serialized_start=1461
+  _ACTIONOUTCOME._serialized_end=1560
   _GETCLUSTERMEMBERSREQUEST._serialized_start=88
   _GETCLUSTERMEMBERSREQUEST._serialized_end=114
   _CLUSTERMEMBER._serialized_start=116
@@ -169,15 +189,15 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   _GETRUNNABLEACTIONINSTANCESREQUEST._serialized_start=655
   _GETRUNNABLEACTIONINSTANCESREQUEST._serialized_end=725
   _RUNNABLEACTION._serialized_start=728
-  _RUNNABLEACTION._serialized_end=917
-  _RUNNABLEACTION_PARAMSENTRY._serialized_start=848
-  _RUNNABLEACTION_PARAMSENTRY._serialized_end=917
-  _GETRUNNABLEACTIONINSTANCESRESPONSE._serialized_start=919
-  _GETRUNNABLEACTIONINSTANCESRESPONSE._serialized_end=1000
-  _UPDATEACTIONSTATUSREQUEST._serialized_start=1003
-  _UPDATEACTIONSTATUSREQUEST._serialized_end=1136
-  _UPDATEACTIONSTATUSRESPONSE._serialized_start=1138
-  _UPDATEACTIONSTATUSRESPONSE._serialized_end=1166
-  _MODELBOXADMIN._serialized_start=1169
-  _MODELBOXADMIN._serialized_end=1648
+  _RUNNABLEACTION._serialized_end=936
+  _RUNNABLEACTION_PARAMSENTRY._serialized_start=867
+  _RUNNABLEACTION_PARAMSENTRY._serialized_end=936
+  _GETRUNNABLEACTIONINSTANCESRESPONSE._serialized_start=938
+  _GETRUNNABLEACTIONINSTANCESRESPONSE._serialized_end=1019
+  _UPDATEACTIONSTATUSREQUEST._serialized_start=1022
+  _UPDATEACTIONSTATUSREQUEST._serialized_end=1247
+  _UPDATEACTIONSTATUSRESPONSE._serialized_start=1249
+  _UPDATEACTIONSTATUSRESPONSE._serialized_end=1277
+  _MODELBOXADMIN._serialized_start=1563
+  _MODELBOXADMIN._serialized_end=2042
 # @@protoc_insertion_point(module_scope)
@@ -3,7 +3,7 @@ from os import times
 from typing import Any, Union, Dict, List
 import json
 from dataclasses import dataclass
-from hashlib import md5
+from hashlib import sha256
 
 import grpc
 from . import service_pb2
@@ -17,10 +17,11 @@ CHUNK_SZ = 1024
 
 
 def file_checksum(path) -> str:
-    checksum = ""
+    h = sha256()
     with open(path, "rb") as f:
-        checksum = md5(f.read()).hexdigest()
-    return checksum
+        for data in iter(lambda: f.read(1 << 20), b""):
+            h.update(data)
+    return h.hexdigest()
 
 
 @dataclass
@@ -131,6 +132,7 @@ class ModelBoxClient:
         file_meta = service_pb2.FileMetadata(
             parent_id=object_id,
             checksum=checksum,
+            checksum_algorithm=service_pb2.SHA256,
             file_type=file_type_proto,
             src_path=path,
         )
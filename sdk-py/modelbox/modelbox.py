@@ -1,6 +1,6 @@
 from __future__ import annotations
 from genericpath import isdir
-from typing import Dict, List, Any, Union
+from typing import Dict, List, Any, Optional, Union
 from enum import Enum
 from dataclasses import InitVar, dataclass, field
 import json
@@ -82,28 +82,39 @@ class ArtifactMime(Enum):
 
 
 class LocalFile:
+    # Checksums passed without an algorithm are MD5, the algorithm files were
+    # checksummed with before the algorithm was sent along. Checksums computed
+    # by the SDK are SHA256.
     def __init__(
         self,
         path: str,
         checksum: str = "",
         mime_type: ArtifactMime = ArtifactMime.Unknown,
+        checksum_algorithm: Optional[int] = None,
     ):
+        if checksum_algorithm is None:
+            checksum_algorithm = service_pb2.MD5 if checksum else service_pb2.SHA256
         self.path = path
         self.checksum = checksum
         self.mime_type = mime_type
+        self.checksum_algorithm = checksum_algorithm
 
     @classmethod
-    def from_path(cls, p: str, checksum: str = "") -> LocalFile:
+    def from_path(
+        cls, p: str, checksum: str = "", checksum_algorithm: Optional[int] = None
+    ) -> LocalFile:
         p = os.path.abspath(p)
-        _checksum = file_checksum(p) if checksum == "" else checksum
+        if checksum == "":
+            checksum, checksum_algorithm = file_checksum(p), service_pb2.SHA256
         mime_type = ArtifactMime.from_path(p)
-        return cls(p, _checksum, mime_type)
+        return cls(p, checksum, mime_type, checksum_algorithm)
 
     def to_proto(self, parent_id: str) -> service_pb2.FileMetadata:
         return service_pb2.FileMetadata(
             parent_id=parent_id,
             src_path=self.path,
             checksum=self.checksum,
+            checksum_algorithm=self.checksum_algorithm,
             file_type=self.mime_type.to_proto(),
         )
 
@@ -17,17 +17,33 @@ from google.protobuf import timestamp_pb2 as google_dot_protobuf_dot_timestamp__
 from google.protobuf import struct_pb2 as google_dot_protobuf_dot_struct__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\rservice.proto\x12\x08modelbox\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\"9\n\x15WatchNamespaceRequest\x12\x11\n\tnamespace\x18\x01 \x01(\t\x12\r\n\x05since\x18\x02 \x01(\x04\"g\n\x16WatchNamespaceResponse\x12$\n\x05\x65vent\x18\x01 \x01(\x0e\x32\x15.modelbox.ChangeEvent\x12\'\n\x07payload\x18\x02 \x01(\x0b\x32\x16.google.protobuf.Value\">\n\x07Metrics\x12\x0b\n\x03key\x18\x01 \x01(\t\x12&\n\x06values\x18\x02 \x03(\x0b\x32\x16.modelbox.MetricsValue\"v\n\x0cMetricsValue\x12\x0c\n\x04step\x18\x01 \x01(\x04\x12\x16\n\x0ewallclock_time\x18\x02 \x01(\x04\x12\x0f\n\x05\x66_val\x18\x05 \x01(\x02H\x00\x12\x12\n\x08s_tensor\x18\x06 \x01(\tH\x00\x12\x12\n\x08\x62_tensor\x18\x07 \x01(\x0cH\x00\x42\x07\n\x05value\"Z\n\x11LogMetricsRequest\x12\x11\n\tparent_id\x18\x01 \x01(\t\x12\x0b\n\x03key\x18\x02 \x01(\t\x12%\n\x05value\x18\x03 \x01(\x0b\x32\x16.modelbox.MetricsValue\"\x14\n\x12LogMetricsResponse\"&\n\x11GetMetricsRequest\x12\x11\n\tparent_id\x18\x01 \x01(\t\"\x93\x01\n\x12GetMetricsResponse\x12:\n\x07metrics\x18\x01 \x03(\x0b\x32).modelbox.GetMetricsResponse.MetricsEntry\x1a\x41\n\x0cMetricsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12 \n\x05value\x18\x02 \x01(\x0b\x32\x11.modelbox.Metrics:\x02\x38\x01\"_\n\x15TrackArtifactsRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tobject_id\x18\x02 \x01(\t\x12%\n\x05\x66iles\x18\x03 \x03(\x0b\x32\x16.modelbox.FileMetadata\"$\n\x16TrackArtifactsResponse\x12\n\n\x02id\x18\x01 \x01(\t\")\n\x14ListArtifactsRequest\x12\x11\n\tobject_id\x18\x01 \x01(\t\">\n\x15ListArtifactsResponse\x12%\n\tartifacts\x18\x01 \x03(\x0b\x32\x12.modelbox.Artifact\"\xed\x01\n\x0c\x46ileMetadata\x12\n\n\x02id\x18\x01 \x01(\t\x12\x11\n\tparent_id\x18\x02 \x01(\t\x12%\n\tfile_type\x18\x03 \x01(\x0e\x32\x12.modelbox.FileType\x12\x10\n\x08\x63hecksum\x18\x04 \x01(\t\x12\x10\n\x08src_path\x18\x05 \x01(\t\x12\x13\n\x0bupload_path\x18\x06 \x01(\t\x12.\n\ncreated_at\x18\x14 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x15 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"&\n\x13\x44ownloadFileRequest\x12\x0f\n\x07\x66ile_id\x18\x01 \x01(\t\"d\n\x14\x44ownloadFileResponse\x12*\n\x08metadata\x18\x01 \x01(\x0b\x32\x16.modelbox.FileMetadataH\x00\x12\x10\n\x06\x63hunks\x18\x02 \x01(\x0cH\x00\x42\x0e\n\x0cstream_frame\"g\n\x11UploadFileRequest\x12\x30\n\x08metadata\x18\x01 \x01(\x0b\x32\x1c.modelbox.UploadFileMetadataH\x00\x12\x10\n\x06\x63hunks\x18\x02 \x01(\x0cH\x00\x42\x0e\n\x0cstream_frame\":\n\x12UploadFileResponse\x12\x0f\n\x07\x66ile_id\x18\x01 \x01(\t\x12\x13\n\x0b\x61rtifact_id\x18\x02 \x01(\t\"h\n\x12UploadFileMetadata\x12\x15\n\rartifact_name\x18\x01 \x01(\t\x12\x11\n\tobject_id\x18\x02 \x01(\t\x12(\n\x08metadata\x18\x03 \x01(\x0b\x32\x16.modelbox.FileMetadata\"^\n\x08\x41rtifact\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x11\n\tobject_id\x18\x03 \x01(\t\x12%\n\x05\x66iles\x18\x04 \x03(\x0b\x32\x16.modelbox.FileMetadata\"\xc6\x01\n\x05Model\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\r\n\x05owner\x18\x03 \x01(\t\x12\x11\n\tnamespace\x18\x04 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x05 \x01(\t\x12\x0c\n\x04task\x18\x06 \x01(\t\x12.\n\ncreated_at\x18\x14 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x15 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"g\n\x12\x43reateModelRequest\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\r\n\x05owner\x18\x03 \x01(\t\x12\x11\n\tnamespace\x18\x04 \x01(\t\x12\x0c\n\x04task\x18\x05 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x06 \x01(\t\"\x91\x01\n\x13\x43reateModelResponse\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0e\n\x06\x65xists\x18\x02 \x01(\x08\x12.\n\ncreated_at\x18\x14 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x15 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\xff\x01\n\x0cModelVersion\x12\n\n\x02id\x18\x01 \x01(\t\x12\x10\n\x08model_id\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x0f\n\x07version\x18\x04 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x05 \x01(\t\x12(\n\tframework\x18\x08 \x01(\x0e\x32\x15.modelbox.MLFramework\x12\x13\n\x0bunique_tags\x18\t \x03(\t\x12.\n\ncreated_at\x18\x14 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x15 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\xb0\x01\n\x19\x43reateModelVersionRequest\x12\r\n\x05model\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0f\n\x07version\x18\x03 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x04 \x01(\t\x12\x11\n\tnamespace\x18\x05 \x01(\t\x12(\n\tframework\x18\x08 \x01(\x0e\x32\x15.modelbox.MLFramework\x12\x13\n\x0bunique_tags\x18\t \x03(\t\"\xa3\x01\n\x1a\x43reateModelVersionResponse\x12\x15\n\rmodel_version\x18\x01 \x01(\t\x12\x0e\n\x06\x65xists\x18\x02 \x01(\x08\x12.\n\ncreated_at\x18\x14 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x15 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\xe7\x01\n\nExperiment\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x11\n\tnamespace\x18\x03 \x01(\t\x12\r\n\x05owner\x18\x04 \x01(\t\x12(\n\tframework\x18\x05 \x01(\x0e\x32\x15.modelbox.MLFramework\x12\x13\n\x0b\x65xternal_id\x18\x07 \x01(\t\x12.\n\ncreated_at\x18\x14 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x15 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\x96\x01\n\x17\x43reateExperimentRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05owner\x18\x02 \x01(\t\x12\x11\n\tnamespace\x18\x03 \x01(\t\x12(\n\tframework\x18\x04 \x01(\x0e\x32\x15.modelbox.MLFramework\x12\x0c\n\x04task\x18\x05 \x01(\t\x12\x13\n\x0b\x65xternal_id\x18\x07 \x01(\t\"\xac\x01\n\x18\x43reateExperimentResponse\x12\x15\n\rexperiment_id\x18\x01 \x01(\t\x12\x19\n\x11\x65xperiment_exists\x18\x02 \x01(\x08\x12.\n\ncreated_at\x18\x14 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x15 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"+\n\x16ListExperimentsRequest\x12\x11\n\tnamespace\x18\x01 \x01(\t\"D\n\x17ListExperimentsResponse\x12)\n\x0b\x65xperiments\x18\x01 \x03(\x0b\x32\x14.modelbox.Experiment\")\n\x18ListModelVersionsRequest\x12\r\n\x05model\x18\x01 \x01(\t\"K\n\x19ListModelVersionsResponse\x12.\n\x0emodel_versions\x18\x01 \x03(\x0b\x32\x16.modelbox.ModelVersion\"&\n\x11ListModelsRequest\x12\x11\n\tnamespace\x18\x01 \x01(\t\"5\n\x12ListModelsResponse\x12\x1f\n\x06models\x18\x01 \x03(\x0b\x32\x0f.modelbox.Model\"o\n\x08Metadata\x12\x32\n\x08metadata\x18\x01 \x03(\x0b\x32 .modelbox.Metadata.MetadataEntry\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"P\n\x15UpdateMetadataRequest\x12\x11\n\tparent_id\x18\x01 \x01(\t\x12$\n\x08metadata\x18\x02 \x01(\x0b\x32\x12.modelbox.Metadata\"\x18\n\x16UpdateMetadataResponse\"(\n\x13ListMetadataRequest\x12\x11\n\tparent_id\x18\x01 \x01(\t\"<\n\x14ListMetadataResponse\x12$\n\x08metadata\x18\x01 \x01(\x0b\x32\x12.modelbox.Metadata\"\x1b\n\x0b\x45ventSource\x12\x0c\n\x04name\x18\x01 \x01(\t\"\x96\x01\n\x05\x45vent\x12\x0c\n\x04name\x18\x02 \x01(\t\x12%\n\x06source\x18\x03 \x01(\x0b\x32\x15.modelbox.EventSource\x12\x32\n\x0ewallclock_time\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12$\n\x08metadata\x18\x05 \x01(\x0b\x32\x12.modelbox.Metadata\"D\n\x0fLogEventRequest\x12\x11\n\tparent_id\x18\x01 \x01(\t\x12\x1e\n\x05\x65vent\x18\x02 \x01(\x0b\x32\x0f.modelbox.Event\"B\n\x10LogEventResponse\x12.\n\ncreated_at\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"Q\n\x11ListEventsRequest\x12\x11\n\tparent_id\x18\x01 \x01(\t\x12)\n\x05since\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"5\n\x12ListEventsResponse\x12\x1f\n\x06\x65vents\x18\x01 \x03(\x0b\x32\x0f.modelbox.Event\"\"\n\x14GetExperimentRequest\x12\n\n\x02id\x18\x01 \x01(\t\"A\n\x15GetExperimentResponse\x12(\n\nexperiment\x18\x01 \x01(\x0b\x32\x14.modelbox.Experiment*Q\n\x0b\x43hangeEvent\x12\x1a\n\x16\x43HANGE_EVENT_UNDEFINED\x10\x00\x12\x12\n\x0eOBJECT_CREATED\x10\x01\x12\x12\n\x0eOBJECT_UPDATED\x10\x02*_\n\x08\x46ileType\x12\r\n\tUNDEFINED\x10\x00\x12\t\n\x05MODEL\x10\x01\x12\x0e\n\nCHECKPOINT\x10\x02\x12\x08\n\x04TEXT\x10\x03\x12\t\n\x05IMAGE\x10\x04\x12\t\n\x05\x41UDIO\x10\x05\x12\t\n\x05VIDEO\x10\x06*2\n\x0bMLFramework\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x0b\n\x07PYTORCH\x10\x01\x12\t\n\x05KERAS\x10\x02\x32\xc1\x0b\n\nModelStore\x12J\n\x0b\x43reateModel\x12\x1c.modelbox.CreateModelRequest\x1a\x1d.modelbox.CreateModelResponse\x12G\n\nListModels\x12\x1b.modelbox.ListModelsRequest\x1a\x1c.modelbox.ListModelsResponse\x12_\n\x12\x43reateModelVersion\x12#.modelbox.CreateModelVersionRequest\x1a$.modelbox.CreateModelVersionResponse\x12\\\n\x11ListModelVersions\x12\".modelbox.ListModelVersionsRequest\x1a#.modelbox.ListModelVersionsResponse\x12Y\n\x10\x43reateExperiment\x12!.modelbox.CreateExperimentRequest\x1a\".modelbox.CreateExperimentResponse\x12V\n\x0fListExperiments\x12 .modelbox.ListExperimentsRequest\x1a!.modelbox.ListExperimentsResponse\x12P\n\rGetExperiment\x12\x1e.modelbox.GetExperimentRequest\x1a\x1f.modelbox.GetExperimentResponse\x12I\n\nUploadFile\x12\x1b.modelbox.UploadFileRequest\x1a\x1c.modelbox.UploadFileResponse(\x01\x12O\n\x0c\x44ownloadFile\x12\x1d.modelbox.DownloadFileRequest\x1a\x1e.modelbox.DownloadFileResponse0\x01\x12S\n\x0eUpdateMetadata\x12\x1f.modelbox.UpdateMetadataRequest\x1a .modelbox.UpdateMetadataResponse\x12M\n\x0cListMetadata\x12\x1d.modelbox.ListMetadataRequest\x1a\x1e.modelbox.ListMetadataResponse\x12S\n\x0eTrackArtifacts\x12\x1f.modelbox.TrackArtifactsRequest\x1a .modelbox.TrackArtifactsResponse\x12P\n\rListArtifacts\x12\x1e.modelbox.ListArtifactsRequest\x1a\x1f.modelbox.ListArtifactsResponse\x12G\n\nLogMetrics\x12\x1b.modelbox.LogMetricsRequest\x1a\x1c.modelbox.LogMetricsResponse\x12G\n\nGetMetrics\x12\x1b.modelbox.GetMetricsRequest\x1a\x1c.modelbox.GetMetricsResponse\x12\x41\n\x08LogEvent\x12\x19.modelbox.LogEventRequest\x1a\x1a.modelbox.LogEventResponse\x12G\n\nListEvents\x12\x1b.modelbox.ListEventsRequest\x1a\x1c.modelbox.ListEventsResponse\x12U\n\x0eWatchNamespace\x12\x1f.modelbox.WatchNamespaceRequest\x1a .modelbox.WatchNamespaceResponse0\x01\x42-Z+github.com/tensorland/modelbox/sdk-go/protob\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\rservice.proto\x12\x08modelbox\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/protobuf/struct.proto\"\xf3\x01\n\x15WatchNamespaceRequest\x12\x11\n\tnamespace\x18\x01 \x01(\t\x12\r\n\x05since\x18\x02 \x01(\x04\x12\x16\n\x0e\x61\x66ter_position\x18\x03 \x01(\x04\x12*\n\x0cobject_kinds\x18\x04 \x03(\x0e\x32\x14.modelbox.ObjectKind\x12%\n\x06\x65vents\x18\x05 \x03(\x0e\x32\x15.modelbox.ChangeEvent\x12\r\n\x05owner\x18\x06 \x01(\t\x12+\n\x0cml_framework\x18\x07 \x01(\x0e\x32\x15.modelbox.MLFramework\x12\x11\n\tname_glob\x18\x08 \x01(\t\"\xb7\x01\n\x16WatchNamespaceResponse\x12$\n\x05\x65vent\x18\x01 \x01(\x0e\x32\x15.modelbox.ChangeEvent\x12\'\n\x07payload\x18\x02 \x01(\x0b\x32\x16.google.protobuf.Value\x12\x10\n\x08position\x18\x03 \x01(\x04\x12)\n\x0bobject_kind\x18\x04 \x01(\x0e\x32\x14.modelbox.ObjectKind\x12\x11\n\tobject_id\x18\x05 \x01(\t\">\n\x07Metrics\x12\x0b\n\x03key\x18\x01 \x01(\t\x12&\n\x06values\x18\x02 \x03(\x0b\x32\x16.modelbox.MetricsValue\"v\n\x0cMetricsValue\x12\x0c\n\x04step\x18\x01 \x01(\x04\x12\x16\n\x0ewallclock_time\x18\x02 \x01(\x04\x12\x0f\n\x05\x66_val\x18\x05 \x01(\x02H\x00\x12\x12\n\x08s_tensor\x18\x06 \x01(\tH\x00\x12\x12\n\x08\x62_tensor\x18\x07 \x01(\x0cH\x00\x42\x07\n\x05value\"s\n\x11LogMetricsRequest\x12\x11\n\tparent_id\x18\x01 \x01(\t\x12\x0b\n\x03key\x18\x02 \x01(\t\x12%\n\x05value\x18\x03 \x01(\x0b\x32\x16.modelbox.MetricsValue\x12\x17\n\x0fidempotency_key\x18\x04 \x01(\t\"\x14\n\x12LogMetricsResponse\"&\n\x11GetMetricsRequest\x12\x11\n\tparent_id\x18\x01 \x01(\t\"\x93\x01\n\x12GetMetricsResponse\x12:\n\x07metrics\x18\x01 \x03(\x0b\x32).modelbox.GetMetricsResponse.MetricsEntry\x1a\x41\n\x0cMetricsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12 \n\x05value\x18\x02 \x01(\x0b\x32\x11.modelbox.Metrics:\x02\x38\x01\"_\n\x15TrackArtifactsRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tobject_id\x18\x02 \x01(\t\x12%\n\x05\x66iles\x18\x03 \x03(\x0b\x32\x16.modelbox.FileMetadata\"$\n\x16TrackArtifactsResponse\x12\n\n\x02id\x18\x01 \x01(\t\"P\n\x14ListArtifactsRequest\x12\x11\n\tobject_id\x18\x01 \x01(\t\x12\x11\n\tpage_size\x18\x02 \x01(\r\x12\x12\n\npage_token\x18\x03 \x01(\t\"W\n\x15ListArtifactsResponse\x12%\n\tartifacts\x18\x01 \x03(\x0b\x32\x12.modelbox.Artifact\x12\x17\n\x0fnext_page_token\x18\x02 \x01(\t\"\xb4\x02\n\x0c\x46ileMetadata\x12\n\n\x02id\x18\x01 \x01(\t\x12\x11\n\tparent_id\x18\x02 \x01(\t\x12%\n\tfile_type\x18\x03 \x01(\x0e\x32\x12.modelbox.FileType\x12\x10\n\x08\x63hecksum\x18\x04 \x01(\t\x12\x37\n\x12\x63hecksum_algorithm\x18\x08 \x01(\x0e\x32\x1b.modelbox.ChecksumAlgorithm\x12\x10\n\x08src_path\x18\x05 \x01(\t\x12\x13\n\x0bupload_path\x18\x06 \x01(\t\x12\x0c\n\x04size\x18\x07 \x01(\x04\x12.\n\ncreated_at\x18\x14 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x15 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"F\n\x13\x44ownloadFileRequest\x12\x0f\n\x07\x66ile_id\x18\x01 \x01(\t\x12\x0e\n\x06offset\x18\x02 \x01(\x04\x12\x0e\n\x06length\x18\x03 \x01(\x04\"\x8a\x01\n\x14\x44ownloadFileResponse\x12*\n\x08metadata\x18\x01 \x01(\x0b\x32\x16.modelbox.FileMetadataH\x00\x12\x10\n\x06\x63hunks\x18\x02 \x01(\x0cH\x00\x12$\n\x05\x63hunk\x18\x03 \x01(\x0b\x32\x13.modelbox.FileChunkH\x00\x42\x0e\n\x0cstream_frame\"\xbb\x01\n\x11UploadFileRequest\x12\x30\n\x08metadata\x18\x01 \x01(\x0b\x32\x1c.modelbox.UploadFileMetadataH\x00\x12\x10\n\x06\x63hunks\x18\x02 \x01(\x0cH\x00\x12$\n\x05\x63hunk\x18\x03 \x01(\x0b\x32\x13.modelbox.FileChunkH\x00\x12,\n\x06\x63ommit\x18\x04 \x01(\x0b\x32\x1a.modelbox.UploadFileCommitH\x00\x42\x0e\n\x0cstream_frame\"9\n\tFileChunk\x12\x0e\n\x06offset\x18\x01 \x01(\x04\x12\x0c\n\x04\x64\x61ta\x18\x02 \x01(\x0c\x12\x0e\n\x06\x63rc32c\x18\x03 \x01(\r\"2\n\x10UploadFileCommit\x12\x0c\n\x04size\x18\x01 \x01(\x04\x12\x10\n\x08\x63hecksum\x18\x02 \x01(\t\"z\n\x12UploadFileResponse\x12\x0f\n\x07\x66ile_id\x18\x01 \x01(\t\x12\x13\n\x0b\x61rtifact_id\x18\x02 \x01(\t\x12\x11\n\tupload_id\x18\x03 \x01(\t\x12\x18\n\x10\x63ommitted_offset\x18\x04 \x01(\x04\x12\x11\n\tcompleted\x18\x05 \x01(\x08\"\x90\x01\n\x12UploadFileMetadata\x12\x15\n\rartifact_name\x18\x01 \x01(\t\x12\x11\n\tobject_id\x18\x02 \x01(\t\x12(\n\x08metadata\x18\x03 \x01(\x0b\x32\x16.modelbox.FileMetadata\x12\x11\n\tupload_id\x18\x04 \x01(\t\x12\x13\n\x0bpart_number\x18\x05 \x01(\r\"Q\n\nUploadPart\x12\x13\n\x0bpart_number\x18\x01 \x01(\r\x12\x0e\n\x06offset\x18\x02 \x01(\x04\x12\x0c\n\x04size\x18\x03 \x01(\x04\x12\x10\n\x08\x63hecksum\x18\x04 \x01(\t\"\x8c\x01\n\x15\x43ompleteUploadRequest\x12.\n\x08metadata\x18\x01 \x01(\x0b\x32\x1c.modelbox.UploadFileMetadata\x12#\n\x05parts\x18\x02 \x03(\x0b\x32\x14.modelbox.UploadPart\x12\x0c\n\x04size\x18\x03 \x01(\x04\x12\x10\n\x08\x63hecksum\x18\x04 \x01(\t\"^\n\x08\x41rtifact\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x11\n\tobject_id\x18\x03 \x01(\t\x12%\n\x05\x66iles\x18\x04 \x03(\x0b\x32\x16.modelbox.FileMetadata\"\xc6\x01\n\x05Model\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\r\n\x05owner\x18\x03 \x01(\t\x12\x11\n\tnamespace\x18\x04 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x05 \x01(\t\x12\x0c\n\x04task\x18\x06 \x01(\t\x12.\n\ncreated_at\x18\x14 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x15 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\x80\x01\n\x12\x43reateModelRequest\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\r\n\x05owner\x18\x03 \x01(\t\x12\x11\n\tnamespace\x18\x04 \x01(\t\x12\x0c\n\x04task\x18\x05 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x06 \x01(\t\x12\x17\n\x0fidempotency_key\x18\x07 \x01(\t\"\x91\x01\n\x13\x43reateModelResponse\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0e\n\x06\x65xists\x18\x02 \x01(\x08\x12.\n\ncreated_at\x18\x14 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x15 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\xff\x01\n\x0cModelVersion\x12\n\n\x02id\x18\x01 \x01(\t\x12\x10\n\x08model_id\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x0f\n\x07version\x18\x04 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x05 \x01(\t\x12(\n\tframework\x18\x08 \x01(\x0e\x32\x15.modelbox.MLFramework\x12\x13\n\x0bunique_tags\x18\t \x03(\t\x12.\n\ncreated_at\x18\x14 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x15 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\xc9\x01\n\x19\x43reateModelVersionRequest\x12\r\n\x05model\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x0f\n\x07version\x18\x03 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x04 \x01(\t\x12\x11\n\tnamespace\x18\x05 \x01(\t\x12(\n\tframework\x18\x08 \x01(\x0e\x32\x15.modelbox.MLFramework\x12\x13\n\x0bunique_tags\x18\t \x03(\t\x12\x17\n\x0fidempotency_key\x18\n \x01(\t\"\xa3\x01\n\x1a\x43reateModelVersionResponse\x12\x15\n\rmodel_version\x18\x01 \x01(\t\x12\x0e\n\x06\x65xists\x18\x02 \x01(\x08\x12.\n\ncreated_at\x18\x14 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x15 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\xe7\x01\n\nExperiment\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04name\x18\x02 \x01(\t\x12\x11\n\tnamespace\x18\x03 \x01(\t\x12\r\n\x05owner\x18\x04 \x01(\t\x12(\n\tframework\x18\x05 \x01(\x0e\x32\x15.modelbox.MLFramework\x12\x13\n\x0b\x65xternal_id\x18\x07 \x01(\t\x12.\n\ncreated_at\x18\x14 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x15 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\xaf\x01\n\x17\x43reateExperimentRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\r\n\x05owner\x18\x02 \x01(\t\x12\x11\n\tnamespace\x18\x03 \x01(\t\x12(\n\tframework\x18\x04 \x01(\x0e\x32\x15.modelbox.MLFramework\x12\x0c\n\x04task\x18\x05 \x01(\t\x12\x13\n\x0b\x65xternal_id\x18\x07 \x01(\t\x12\x17\n\x0fidempotency_key\x18\x08 \x01(\t\"\xac\x01\n\x18\x43reateExperimentResponse\x12\x15\n\rexperiment_id\x18\x01 \x01(\t\x12\x19\n\x11\x65xperiment_exists\x18\x02 \x01(\x08\x12.\n\ncreated_at\x18\x14 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12.\n\nupdated_at\x18\x15 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"C\n\tSortOrder\x12\"\n\x05\x66ield\x18\x01 \x01(\x0e\x32\x13.modelbox.SortField\x12\x12\n\ndescending\x18\x02 \x01(\x08\"b\n\tTimeRange\x12)\n\x05\x61\x66ter\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12*\n\x06\x62\x65\x66ore\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"\x82\x03\n\x16ListExperimentsRequest\x12\x11\n\tnamespace\x18\x01 \x01(\t\x12\x11\n\tpage_size\x18\x02 \x01(\r\x12\x12\n\npage_token\x18\x03 \x01(\t\x12\r\n\x05owner\x18\x04 \x01(\t\x12(\n\tframework\x18\x05 \x01(\x0e\x32\x15.modelbox.MLFramework\x12\x13\n\x0b\x65xternal_id\x18\x06 \x01(\t\x12$\n\x07\x63reated\x18\x07 \x01(\x0b\x32\x13.modelbox.TimeRange\x12$\n\x07updated\x18\x08 \x01(\x0b\x32\x13.modelbox.TimeRange\x12@\n\x08metadata\x18\t \x03(\x0b\x32..modelbox.ListExperimentsRequest.MetadataEntry\x12!\n\x04sort\x18\n \x01(\x0b\x32\x13.modelbox.SortOrder\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"]\n\x17ListExperimentsResponse\x12)\n\x0b\x65xperiments\x18\x01 \x03(\x0b\x32\x14.modelbox.Experiment\x12\x17\n\x0fnext_page_token\x18\x02 \x01(\t\"X\n\rSearchRequest\x12\x11\n\tnamespace\x18\x01 \x01(\t\x12\r\n\x05query\x18\x02 \x01(\t\x12\x11\n\tpage_size\x18\x03 \x01(\r\x12\x12\n\npage_token\x18\x04 \x01(\t\"G\n\rMetricSummary\x12\x0e\n\x06latest\x18\x01 \x01(\x02\x12\x0c\n\x04step\x18\x02 \x01(\x04\x12\x0b\n\x03min\x18\x03 \x01(\x02\x12\x0b\n\x03max\x18\x04 \x01(\x02\"\xa0\x02\n\x0cSearchResult\x12(\n\nexperiment\x18\x01 \x01(\x0b\x32\x14.modelbox.Experiment\x12\x36\n\x08metadata\x18\x02 \x03(\x0b\x32$.modelbox.SearchResult.MetadataEntry\x12\x34\n\x07metrics\x18\x03 \x03(\x0b\x32#.modelbox.SearchResult.MetricsEntry\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\x1aG\n\x0cMetricsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12&\n\x05value\x18\x02 \x01(\x0b\x32\x17.modelbox.MetricSummary:\x02\x38\x01\"R\n\x0eSearchResponse\x12\'\n\x07results\x18\x01 \x03(\x0b\x32\x16.modelbox.SearchResult\x12\x17\n\x0fnext_page_token\x18\x02 \x01(\t\"P\n\x18ListModelVersionsRequest\x12\r\n\x05model\x18\x01 \x01(\t\x12\x11\n\tpage_size\x18\x02 \x01(\r\x12\x12\n\npage_token\x18\x03 \x01(\t\"d\n\x19ListModelVersionsResponse\x12.\n\x0emodel_versions\x18\x01 \x03(\x0b\x32\x16.modelbox.ModelVersion\x12\x17\n\x0fnext_page_token\x18\x02 \x01(\t\"\xc7\x02\n\x11ListModelsRequest\x12\x11\n\tnamespace\x18\x01 \x01(\t\x12\x11\n\tpage_size\x18\x02 \x01(\r\x12\x12\n\npage_token\x18\x03 \x01(\t\x12\r\n\x05owner\x18\x04 \x01(\t\x12\x0c\n\x04task\x18\x05 \x01(\t\x12$\n\x07\x63reated\x18\x06 \x01(\x0b\x32\x13.modelbox.TimeRange\x12$\n\x07updated\x18\x07 \x01(\x0b\x32\x13.modelbox.TimeRange\x12;\n\x08metadata\x18\x08 \x03(\x0b\x32).modelbox.ListModelsRequest.MetadataEntry\x12!\n\x04sort\x18\t \x01(\x0b\x32\x13.modelbox.SortOrder\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"N\n\x12ListModelsResponse\x12\x1f\n\x06models\x18\x01 \x03(\x0b\x32\x0f.modelbox.Model\x12\x17\n\x0fnext_page_token\x18\x02 \x01(\t\"o\n\x08Metadata\x12\x32\n\x08metadata\x18\x01 \x03(\x0b\x32 .modelbox.Metadata.MetadataEntry\x1a/\n\rMetadataEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"P\n\x15UpdateMetadataRequest\x12\x11\n\tparent_id\x18\x01 \x01(\t\x12$\n\x08metadata\x18\x02 \x01(\x0b\x32\x12.modelbox.Metadata\"\x18\n\x16UpdateMetadataResponse\"(\n\x13ListMetadataRequest\x12\x11\n\tparent_id\x18\x01 \x01(\t\"<\n\x14ListMetadataResponse\x12$\n\x08metadata\x18\x01 \x01(\x0b\x32\x12.modelbox.Metadata\"\x1b\n\x0b\x45ventSource\x12\x0c\n\x04name\x18\x01 \x01(\t\"\x96\x01\n\x05\x45vent\x12\x0c\n\x04name\x18\x02 \x01(\t\x12%\n\x06source\x18\x03 \x01(\x0b\x32\x15.modelbox.EventSource\x12\x32\n\x0ewallclock_time\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12$\n\x08metadata\x18\x05 \x01(\x0b\x32\x12.modelbox.Metadata\"]\n\x0fLogEventRequest\x12\x11\n\tparent_id\x18\x01 \x01(\t\x12\x1e\n\x05\x65vent\x18\x02 \x01(\x0b\x32\x0f.modelbox.Event\x12\x17\n\x0fidempotency_key\x18\x03 \x01(\t\"B\n\x10LogEventResponse\x12.\n\ncreated_at\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"x\n\x11ListEventsRequest\x12\x11\n\tparent_id\x18\x01 \x01(\t\x12)\n\x05since\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x11\n\tpage_size\x18\x03 \x01(\r\x12\x12\n\npage_token\x18\x04 \x01(\t\"N\n\x12ListEventsResponse\x12\x1f\n\x06\x65vents\x18\x01 \x03(\x0b\x32\x0f.modelbox.Event\x12\x17\n\x0fnext_page_token\x18\x02 \x01(\t\"\"\n\x14GetExperimentRequest\x12\n\n\x02id\x18\x01 \x01(\t\"A\n\x15GetExperimentResponse\x12(\n\nexperiment\x18\x01 \x01(\x0b\x32\x14.modelbox.Experiment*Q\n\x0b\x43hangeEvent\x12\x1a\n\x16\x43HANGE_EVENT_UNDEFINED\x10\x00\x12\x12\n\x0eOBJECT_CREATED\x10\x01\x12\x12\n\x0eOBJECT_UPDATED\x10\x02*\xad\x01\n\nObjectKind\x12\x19\n\x15OBJECT_KIND_UNDEFINED\x10\x00\x12\x1a\n\x16OBJECT_KIND_EXPERIMENT\x10\x01\x12\x15\n\x11OBJECT_KIND_MODEL\x10\x02\x12\x1d\n\x19OBJECT_KIND_MODEL_VERSION\x10\x03\x12\x18\n\x14OBJECT_KIND_ARTIFACT\x10\x04\x12\x18\n\x14OBJECT_KIND_METADATA\x10\x05*B\n\x11\x43hecksumAlgorithm\x12\x07\n\x03MD5\x10\x00\x12\n\n\x06SHA256\x10\x01\x12\n\n\x06\x42LAKE3\x10\x02\x12\x0c\n\x08XXHASH64\x10\x03*_\n\x08\x46ileType\x12\r\n\tUNDEFINED\x10\x00\x12\t\n\x05MODEL\x10\x01\x12\x0e\n\nCHECKPOINT\x10\x02\x12\x08\n\x04TEXT\x10\x03\x12\t\n\x05IMAGE\x10\x04\x12\t\n\x05\x41UDIO\x10\x05\x12\t\n\x05VIDEO\x10\x06*2\n\x0bMLFramework\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x0b\n\x07PYTORCH\x10\x01\x12\t\n\x05KERAS\x10\x02*V\n\tSortField\x12\x19\n\x15SORT_FIELD_CREATED_AT\x10\x00\x12\x19\n\x15SORT_FIELD_UPDATED_AT\x10\x01\x12\x13\n\x0fSORT_FIELD_NAME\x10\x02\x32\xcf\x0c\n\nModelStore\x12J\n\x0b\x43reateModel\x12\x1c.modelbox.CreateModelRequest\x1a\x1d.modelbox.CreateModelResponse\x12G\n\nListModels\x12\x1b.modelbox.ListModelsRequest\x1a\x1c.modelbox.ListModelsResponse\x12_\n\x12\x43reateModelVersion\x12#.modelbox.CreateModelVersionRequest\x1a$.modelbox.CreateModelVersionResponse\x12\\\n\x11ListModelVersions\x12\".modelbox.ListModelVersionsRequest\x1a#.modelbox.ListModelVersionsResponse\x12Y\n\x10\x43reateExperiment\x12!.modelbox.CreateExperimentRequest\x1a\".modelbox.CreateExperimentResponse\x12V\n\x0fListExperiments\x12 .modelbox.ListExperimentsRequest\x1a!.modelbox.ListExperimentsResponse\x12P\n\rGetExperiment\x12\x1e.modelbox.GetExperimentRequest\x1a\x1f.modelbox.GetExperimentResponse\x12I\n\nUploadFile\x12\x1b.modelbox.UploadFileRequest\x1a\x1c.modelbox.UploadFileResponse(\x01\x12O\n\x0e\x43ompleteUpload\x12\x1f.modelbox.CompleteUploadRequest\x1a\x1c.modelbox.UploadFileResponse\x12O\n\x0c\x44ownloadFile\x12\x1d.modelbox.DownloadFileRequest\x1a\x1e.modelbox.DownloadFileResponse0\x01\x12S\n\x0eUpdateMetadata\x12\x1f.modelbox.UpdateMetadataRequest\x1a .modelbox.UpdateMetadataResponse\x12M\n\x0cListMetadata\x12\x1d.modelbox.ListMetadataRequest\x1a\x1e.modelbox.ListMetadataResponse\x12S\n\x0eTrackArtifacts\x12\x1f.modelbox.TrackArtifactsRequest\x1a .modelbox.TrackArtifactsResponse\x12P\n\rListArtifacts\x12\x1e.modelbox.ListArtifactsRequest\x1a\x1f.modelbox.ListArtifactsResponse\x12G\n\nLogMetrics\x12\x1b.modelbox.LogMetricsRequest\x1a\x1c.modelbox.LogMetricsResponse\x12G\n\nGetMetrics\x12\x1b.modelbox.GetMetricsRequest\x1a\x1c.modelbox.GetMetricsResponse\x12\x41\n\x08LogEvent\x12\x19.modelbox.LogEventRequest\x1a\x1a.modelbox.LogEventResponse\x12G\n\nListEvents\x12\x1b.modelbox.ListEventsRequest\x1a\x1c.modelbox.ListEventsResponse\x12U\n\x0eWatchNamespace\x12\x1f.modelbox.WatchNamespaceRequest\x1a .modelbox.WatchNamespaceResponse0\x01\x12;\n\x06Search\x12\x17.modelbox.SearchRequest\x1a\x18.modelbox.SearchResponseB-Z+github.com/tensorland/modelbox/sdk-go/protob\x06proto3')
 
 _CHANGEEVENT = DESCRIPTOR.enum_types_by_name['ChangeEvent']
 ChangeEvent = enum_type_wrapper.EnumTypeWrapper(_CHANGEEVENT)
+_OBJECTKIND = DESCRIPTOR.enum_types_by_name['ObjectKind']
+ObjectKind = enum_type_wrapper.EnumTypeWrapper(_OBJECTKIND)
+_CHECKSUMALGORITHM = DESCRIPTOR.enum_types_by_name['ChecksumAlgorithm']
+ChecksumAlgorithm = enum_type_wrapper.EnumTypeWrapper(_CHECKSUMALGORITHM)
 _FILETYPE = DESCRIPTOR.enum_types_by_name['FileType']
 FileType = enum_type_wrapper.EnumTypeWrapper(_FILETYPE)
 _MLFRAMEWORK = DESCRIPTOR.enum_types_by_name['MLFramework']
 MLFramework = enum_type_wrapper.EnumTypeWrapper(_MLFRAMEWORK)
+_SORTFIELD = DESCRIPTOR.enum_types_by_name['SortField']
+SortField = enum_type_wrapper.EnumTypeWrapper(_SORTFIELD)
 CHANGE_EVENT_UNDEFINED = 0
 OBJECT_CREATED = 1
 OBJECT_UPDATED = 2
+OBJECT_KIND_UNDEFINED = 0
+OBJECT_KIND_EXPERIMENT = 1
+OBJECT_KIND_MODEL = 2
+OBJECT_KIND_MODEL_VERSION = 3
+OBJECT_KIND_ARTIFACT = 4
+OBJECT_KIND_METADATA = 5
+MD5 = 0
+SHA256 = 1
+BLAKE3 = 2
+XXHASH64 = 3
 UNDEFINED = 0
 MODEL = 1
 CHECKPOINT = 2
@@ -38,6 +54,9 @@ VIDEO = 6
 UNKNOWN = 0
 PYTORCH = 1
 KERAS = 2
+SORT_FIELD_CREATED_AT = 0
+SORT_FIELD_UPDATED_AT = 1
+SORT_FIELD_NAME = 2
 
 
 _WATCHNAMESPACEREQUEST = DESCRIPTOR.message_types_by_name['WatchNamespaceRequest']
@@ -57,8 +76,12 @@ _FILEMETADATA = DESCRIPTOR.message_types_by_name['FileMetadata']
 _DOWNLOADFILEREQUEST = DESCRIPTOR.message_types_by_name['DownloadFileRequest']
 _DOWNLOADFILERESPONSE = DESCRIPTOR.message_types_by_name['DownloadFileResponse']
 _UPLOADFILEREQUEST = DESCRIPTOR.message_types_by_name['UploadFileRequest']
+_FILECHUNK = DESCRIPTOR.message_types_by_name['FileChunk']
+_UPLOADFILECOMMIT = DESCRIPTOR.message_types_by_name['UploadFileCommit']
 _UPLOADFILERESPONSE = DESCRIPTOR.message_types_by_name['UploadFileResponse']
 _UPLOADFILEMETADATA = DESCRIPTOR.message_types_by_name['UploadFileMetadata']
+_UPLOADPART = DESCRIPTOR.message_types_by_name['UploadPart']
+_COMPLETEUPLOADREQUEST = DESCRIPTOR.message_types_by_name['CompleteUploadRequest']
 _ARTIFACT = DESCRIPTOR.message_types_by_name['Artifact']
 _MODEL = DESCRIPTOR.message_types_by_name['Model']
 _CREATEMODELREQUEST = DESCRIPTOR.message_types_by_name['CreateModelRequest']
@@ -69,11 +92,21 @@ _CREATEMODELVERSIONRESPONSE = DESCRIPTOR.message_types_by_name['CreateModelVersi
 _EXPERIMENT = DESCRIPTOR.message_types_by_name['Experiment']
 _CREATEEXPERIMENTREQUEST = DESCRIPTOR.message_types_by_name['CreateExperimentRequest']
 _CREATEEXPERIMENTRESPONSE = DESCRIPTOR.message_types_by_name['CreateExperimentResponse']
+_SORTORDER = DESCRIPTOR.message_types_by_name['SortOrder']
+_TIMERANGE = DESCRIPTOR.message_types_by_name['TimeRange']
 _LISTEXPERIMENTSREQUEST = DESCRIPTOR.message_types_by_name['ListExperimentsRequest']
+_LISTEXPERIMENTSREQUEST_METADATAENTRY = _LISTEXPERIMENTSREQUEST.nested_types_by_name['MetadataEntry']
 _LISTEXPERIMENTSRESPONSE = DESCRIPTOR.message_types_by_name['ListExperimentsResponse']
+_SEARCHREQUEST = DESCRIPTOR.message_types_by_name['SearchRequest']
+_METRICSUMMARY = DESCRIPTOR.message_types_by_name['MetricSummary']
+_SEARCHRESULT = DESCRIPTOR.message_types_by_name['SearchResult']
+_SEARCHRESULT_METADATAENTRY = _SEARCHRESULT.nested_types_by_name['MetadataEntry']
+_SEARCHRESULT_METRICSENTRY = _SEARCHRESULT.nested_types_by_name['MetricsEntry']
+_SEARCHRESPONSE = DESCRIPTOR.message_types_by_name['SearchResponse']
 _LISTMODELVERSIONSREQUEST = DESCRIPTOR.message_types_by_name['ListModelVersionsRequest']
 _LISTMODELVERSIONSRESPONSE = DESCRIPTOR.message_types_by_name['ListModelVersionsResponse']
 _LISTMODELSREQUEST = DESCRIPTOR.message_types_by_name['ListModelsRequest']
+_LISTMODELSREQUEST_METADATAENTRY = _LISTMODELSREQUEST.nested_types_by_name['MetadataEntry']
 _LISTMODELSRESPONSE = DESCRIPTOR.message_types_by_name['ListModelsResponse']
 _METADATA = DESCRIPTOR.message_types_by_name['Metadata']
 _METADATA_METADATAENTRY = _METADATA.nested_types_by_name['MetadataEntry']
@@ -209,6 +242,20 @@ UploadFileRequest = _reflection.GeneratedProtocolMessageType('UploadFileRequest'
   })
 _sym_db.RegisterMessage(UploadFileRequest)
 
+FileChunk = _reflection.GeneratedProtocolMessageType('FileChunk', (_message.Message,), {
+  'DESCRIPTOR' : _FILECHUNK,
+  '__module__' : 'service_pb2'
+  # @@protoc_insertion_point(class_scope:modelbox.FileChunk)
+  })
+_sym_db.RegisterMessage(FileChunk)
+
+UploadFileCommit = _reflection.GeneratedProtocolMessageType('UploadFileCommit', (_message.Message,), {
+  'DESCRIPTOR' : _UPLOADFILECOMMIT,
+  '__module__' : 'service_pb2'
+  # @@protoc_insertion_point(class_scope:modelbox.UploadFileCommit)
+  })
+_sym_db.RegisterMessage(UploadFileCommit)
+
 UploadFileResponse = _reflection.GeneratedProtocolMessageType('UploadFileResponse', (_message.Message,), {
   'DESCRIPTOR' : _UPLOADFILERESPONSE,
   '__module__' : 'service_pb2'
@@ -223,6 +270,20 @@ UploadFileMetadata = _reflection.GeneratedProtocolMessageType('UploadFileMetadat
   })
 _sym_db.RegisterMessage(UploadFileMetadata)
 
+UploadPart = _reflection.GeneratedProtocolMessageType('UploadPart', (_message.Message,), {
+  'DESCRIPTOR' : _UPLOADPART,
+  '__module__' : 'service_pb2'
+  # @@protoc_insertion_point(class_scope:modelbox.UploadPart)
+  })
+_sym_db.RegisterMessage(UploadPart)
+
+CompleteUploadRequest = _reflection.GeneratedProtocolMessageType('CompleteUploadRequest', (_message.Message,), {
+  'DESCRIPTOR' : _COMPLETEUPLOADREQUEST,
+  '__module__' : 'service_pb2'
+  # @@protoc_insertion_point(class_scope:modelbox.CompleteUploadRequest)
+  })
+_sym_db.RegisterMessage(CompleteUploadRequest)
+
 Artifact = _reflection.GeneratedProtocolMessageType('Artifact', (_message.Message,), {
   'DESCRIPTOR' : _ARTIFACT,
   '__module__' : 'service_pb2'
@@ -293,12 +354,34 @@ CreateExperimentResponse = _reflection.GeneratedProtocolMessageType('CreateExper
   })
 _sym_db.RegisterMessage(CreateExperimentResponse)
 
+SortOrder = _reflection.GeneratedProtocolMessageType('SortOrder', (_message.Message,), {
+  'DESCRIPTOR' : _SORTORDER,
+  '__module__' : 'service_pb2'
+  # @@protoc_insertion_point(class_scope:modelbox.SortOrder)
+  })
+_sym_db.RegisterMessage(SortOrder)
+
+TimeRange = _reflection.GeneratedProtocolMessageType('TimeRange', (_message.Message,), {
+  'DESCRIPTOR' : _TIMERANGE,
+  '__module__' : 'service_pb2'
+  # @@protoc_insertion_point(class_scope:modelbox.TimeRange)
+  })
+_sym_db.RegisterMessage(TimeRange)
+
 ListExperimentsRequest = _reflection.GeneratedProtocolMessageType('ListExperimentsRequest', (_message.Message,), {
+
+  'MetadataEntry' : _reflection.GeneratedProtocolMessageType('MetadataEntry', (_message.Message,), {
+    'DESCRIPTOR' : _LISTEXPERIMENTSREQUEST_METADATAENTRY,
+    '__module__' : 'service_pb2'
+    # @@protoc_insertion_point(class_scope:modelbox.ListExperimentsRequest.MetadataEntry)
+    })
+  ,
   'DESCRIPTOR' : _LISTEXPERIMENTSREQUEST,
   '__module__' : 'service_pb2'
   # @@protoc_insertion_point(class_scope:modelbox.ListExperimentsRequest)
   })
 _sym_db.RegisterMessage(ListExperimentsRequest)
+_sym_db.RegisterMessage(ListExperimentsRequest.MetadataEntry)
 
 ListExperimentsResponse = _reflection.GeneratedProtocolMessageType('ListExperimentsResponse', (_message.Message,), {
   'DESCRIPTOR' : _LISTEXPERIMENTSRESPONSE,
@@ -307,6 +390,50 @@ ListExperimentsResponse = _reflection.GeneratedProtocolMessageType('ListExperime
   })
 _sym_db.RegisterMessage(ListExperimentsResponse)
 
+SearchRequest = _reflection.GeneratedProtocolMessageType('SearchRequest', (_message.Message,), {
+  'DESCRIPTOR' : _SEARCHREQUEST,
+  '__module__' : 'service_pb2'
+  # @@protoc_insertion_point(class_scope:modelbox.SearchRequest)
+  })
+_sym_db.RegisterMessage(SearchRequest)
+
+MetricSummary = _reflection.GeneratedProtocolMessageType('MetricSummary', (_message.Message,), {
+  'DESCRIPTOR' : _METRICSUMMARY,
+  '__module__' : 'service_pb2'
+  # @@protoc_insertion_point(class_scope:modelbox.MetricSummary)
+  })
+_sym_db.RegisterMessage(MetricSummary)
+
+SearchResult = _reflection.GeneratedProtocolMessageType('SearchResult', (_message.Message,), {
+
+  'MetadataEntry' : _reflection.GeneratedProtocolMessageType('MetadataEntry', (_message.Message,), {
+    'DESCRIPTOR' : _SEARCHRESULT_METADATAENTRY,
+    '__module__' : 'service_pb2'
+    # @@protoc_insertion_point(class_scope:modelbox.SearchResult.MetadataEntry)
+    })
+  ,
+
+  'MetricsEntry' : _reflection.GeneratedProtocolMessageType('MetricsEntry', (_message.Message,), {
+    'DESCRIPTOR' : _SEARCHRESULT_METRICSENTRY,
+    '__module__' : 'service_pb2'
+    # @@protoc_insertion_point(class_scope:modelbox.SearchResult.MetricsEntry)
+    })
+  ,
+  'DESCRIPTOR' : _SEARCHRESULT,
+  '__module__' : 'service_pb2'
+  # @@protoc_insertion_point(class_scope:modelbox.SearchResult)
+  })
+_sym_db.RegisterMessage(SearchResult)
+_sym_db.RegisterMessage(SearchResult.MetadataEntry)
+_sym_db.RegisterMessage(SearchResult.MetricsEntry)
+
+SearchResponse = _reflection.GeneratedProtocolMessageType('SearchResponse', (_message.Message,), {
+  'DESCRIPTOR' : _SEARCHRESPONSE,
+  '__module__' : 'service_pb2'
+  # @@protoc_insertion_point(class_scope:modelbox.SearchResponse)
+  })
+_sym_db.RegisterMessage(SearchResponse)
+
 ListModelVersionsRequest = _reflection.GeneratedProtocolMessageType('ListModelVersionsRequest', (_message.Message,), {
   'DESCRIPTOR' : _LISTMODELVERSIONSREQUEST,
   '__module__' : 'service_pb2'
@@ -322,11 +449,19 @@ ListModelVersionsResponse = _reflection.GeneratedProtocolMessageType('ListModelV
 _sym_db.RegisterMessage(ListModelVersionsResponse)
 
 ListModelsRequest = _reflection.GeneratedProtocolMessageType('ListModelsRequest', (_message.Message,), {
+
+  'MetadataEntry' : _reflection.GeneratedProtocolMessageType('MetadataEntry', (_message.Message,), {
+    'DESCRIPTOR' : _LISTMODELSREQUEST_METADATAENTRY,
+    '__module__' : 'service_pb2'
+    # @@protoc_insertion_point(class_scope:modelbox.ListModelsRequest.MetadataEntry)
+    })
+  ,
   'DESCRIPTOR' : _LISTMODELSREQUEST,
   '__module__' : 'service_pb2'
   # @@protoc_insertion_point(class_scope:modelbox.ListModelsRequest)
   })
 _sym_db.RegisterMessage(ListModelsRequest)
+_sym_db.RegisterMessage(ListModelsRequest.MetadataEntry)
 
 ListModelsResponse = _reflection.GeneratedProtocolMessageType('ListModelsResponse', (_message.Message,), {
   'DESCRIPTOR' : _LISTMODELSRESPONSE,
@@ -441,112 +576,154 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   DESCRIPTOR._serialized_options = b'Z+github.com/tensorland/modelbox/sdk-go/proto'
   _GETMETRICSRESPONSE_METRICSENTRY._options = None
   _GETMETRICSRESPONSE_METRICSENTRY._serialized_options = b'8\001'
+  _LISTEXPERIMENTSREQUEST_METADATAENTRY._options = None
+  _LISTEXPERIMENTSREQUEST_METADATAENTRY._serialized_options = b'8\001'
+  _SEARCHRESULT_METADATAENTRY._options = None
+  _SEARCHRESULT_METADATAENTRY._serialized_options = b'8\001'
+  _SEARCHRESULT_METRICSENTRY._options = None
+  _SEARCHRESULT_METRICSENTRY._serialized_options = b'8\001'
+  _LISTMODELSREQUEST_METADATAENTRY._options = None
+  _LISTMODELSREQUEST_METADATAENTRY._serialized_options = b'8\001'
   _METADATA_METADATAENTRY._options = None
   _METADATA_METADATAENTRY._serialized_options = b'8\001'
-  _CHANGEEVENT._serialized_start=4568
-  _CHANGEEVENT._serialized_end=4649
-  _FILETYPE._serialized_start=4651
-  _FILETYPE._serialized_end=4746
-  _MLFRAMEWORK._serialized_start=4748
-  _MLFRAMEWORK._serialized_end=4798
-  _WATCHNAMESPACEREQUEST._serialized_start=90
-  _WATCHNAMESPACEREQUEST._serialized_end=147
-  _WATCHNAMESPACERESPONSE._serialized_start=149
-  _WATCHNAMESPACERESPONSE._serialized_end=252
-  _METRICS._serialized_start=254
-  _METRICS._serialized_end=316
-  _METRICSVALUE._serialized_start=318
-  _METRICSVALUE._serialized_end=436
-  _LOGMETRICSREQUEST._serialized_start=438
-  _LOGMETRICSREQUEST._serialized_end=528
-  _LOGMETRICSRESPONSE._serialized_start=530
-  _LOGMETRICSRESPONSE._serialized_end=550
-  _GETMETRICSREQUEST._serialized_start=552
-  _GETMETRICSREQUEST._serialized_end=590
-  _GETMETRICSRESPONSE._serialized_start=593
-  _GETMETRICSRESPONSE._serialized_end=740
-  _GETMETRICSRESPONSE_METRICSENTRY._serialized_start=675
-  _GETMETRICSRESPONSE_METRICSENTRY._serialized_end=740
-  _TRACKARTIFACTSREQUEST._serialized_start=742
-  _TRACKARTIFACTSREQUEST._serialized_end=837
-  _TRACKARTIFACTSRESPONSE._serialized_start=839
-  _TRACKARTIFACTSRESPONSE._serialized_end=875
-  _LISTARTIFACTSREQUEST._serialized_start=877
-  _LISTARTIFACTSREQUEST._serialized_end=918
-  _LISTARTIFACTSRESPONSE._serialized_start=920
-  _LISTARTIFACTSRESPONSE._serialized_end=982
-  _FILEMETADATA._serialized_start=985
-  _FILEMETADATA._serialized_end=1222
-  _DOWNLOADFILEREQUEST._serialized_start=1224
-  _DOWNLOADFILEREQUEST._serialized_end=1262
-  _DOWNLOADFILERESPONSE._serialized_start=1264
-  _DOWNLOADFILERESPONSE._serialized_end=1364
-  _UPLOADFILEREQUEST._serialized_start=1366
-  _UPLOADFILEREQUEST._serialized_end=1469
-  _UPLOADFILERESPONSE._serialized_start=1471
-  _UPLOADFILERESPONSE._serialized_end=1529
-  _UPLOADFILEMETADATA._serialized_start=1531
-  _UPLOADFILEMETADATA._serialized_end=1635
-  _ARTIFACT._serialized_start=1637
-  _ARTIFACT._serialized_end=1731
-  _MODEL._serialized_start=1734
-  _MODEL._serialized_end=1932
-  _CREATEMODELREQUEST._serialized_start=1934
-  _CREATEMODELREQUEST._serialized_end=2037
-  _CREATEMODELRESPONSE._serialized_start=2040
-  _CREATEMODELRESPONSE._serialized_end=2185
-  _MODELVERSION._serialized_start=2188
-  _MODELVERSION._serialized_end=2443
-  _CREATEMODELVERSIONREQUEST._serialized_start=2446
-  _CREATEMODELVERSIONREQUEST._serialized_end=2622
-  _CREATEMODELVERSIONRESPONSE._serialized_start=2625
-  _CREATEMODELVERSIONRESPONSE._serialized_end=2788
-  _EXPERIMENT._serialized_start=2791
-  _EXPERIMENT._serialized_end=3022
-  _CREATEEXPERIMENTREQUEST._serialized_start=3025
-  _CREATEEXPERIMENTREQUEST._serialized_end=3175
-  _CREATEEXPERIMENTRESPONSE._serialized_start=3178
-  _CREATEEXPERIMENTRESPONSE._serialized_end=3350
-  _LISTEXPERIMENTSREQUEST._serialized_start=3352
-  _LISTEXPERIMENTSREQUEST._serialized_end=3395
-  _LISTEXPERIMENTSRESPONSE._serialized_start=3397
-  _LISTEXPERIMENTSRESPONSE._serialized_end=3465
-  _LISTMODELVERSIONSREQUEST._serialized_start=3467
-  _LISTMODELVERSIONSREQUEST._serialized_end=3508
-  _LISTMODELVERSIONSRESPONSE._serialized_start=3510
-  _LISTMODELVERSIONSRESPONSE._serialized_end=3585
-  _LISTMODELSREQUEST._serialized_start=3587
-  _LISTMODELSREQUEST._serialized_end=3625
-  _LISTMODELSRESPONSE._serialized_start=3627
-  _LISTMODELSRESPONSE._serialized_end=3680
-  _METADATA._serialized_start=3682
-  _METADATA._serialized_end=3793
-  _METADATA_METADATAENTRY._serialized_start=3746
-  _METADATA_METADATAENTRY._serialized_end=3793
-  _UPDATEMETADATAREQUEST._serialized_start=3795
-  _UPDATEMETADATAREQUEST._serialized_end=3875
-  _UPDATEMETADATARESPONSE._serialized_start=3877
-  _UPDATEMETADATARESPONSE._serialized_end=3901
-  _LISTMETADATAREQUEST._serialized_start=3903
-  _LISTMETADATAREQUEST._serialized_end=3943
-  _LISTMETADATARESPONSE._serialized_start=3945
-  _LISTMETADATARESPONSE._serialized_end=4005
-  _EVENTSOURCE._serialized_start=4007
-  _EVENTSOURCE._serialized_end=4034
-  _EVENT._serialized_start=4037
-  _EVENT._serialized_end=4187
-  _LOGEVENTREQUEST._serialized_start=4189
-  _LOGEVENTREQUEST._serialized_end=4257
-  _LOGEVENTRESPONSE._serialized_start=4259
-  _LOGEVENTRESPONSE._serialized_end=4325
-  _LISTEVENTSREQUEST._serialized_start=4327
-  _LISTEVENTSREQUEST._serialized_end=4408
-  _LISTEVENTSRESPONSE._serialized_start=4410
-  _LISTEVENTSRESPONSE._serialized_end=4463
-  _GETEXPERIMENTREQUEST._serialized_start=4465
-  _GETEXPERIMENTREQUEST._serialized_end=4499
-  _GETEXPERIMENTRESPONSE._serialized_start=4501
-  _GETEXPERIMENTRESPONSE._serialized_end=4566
-  _MODELSTORE._serialized_start=4801
-  _MODELSTORE._serialized_end=6274
+  _CHANGEEVENT._serialized_start=7214
+  _CHANGEEVENT._serialized_end=7295
+  _OBJECTKIND._serialized_start=7298
+  _OBJECTKIND._serialized_end=7471
+  _CHECKSUMALGORITHM._serialized_start=7473
+  _CHECKSUMALGORITHM._serialized_end=7539
+  _FILETYPE._serialized_start=7541
+  _FILETYPE._serialized_end=7636
+  _MLFRAMEWORK._serialized_start=7638
+  _MLFRAMEWORK._serialized_end=7688
+  _SORTFIELD._serialized_start=7690
+  _SORTFIELD._serialized_end=7776
+  _WATCHNAMESPACEREQUEST._serialized_start=91
+  _WATCHNAMESPACEREQUEST._serialized_end=334
+  _WATCHNAMESPACERESPONSE._serialized_start=337
+  _WATCHNAMESPACERESPONSE._serialized_end=520
+  _METRICS._serialized_start=522
+  _METRICS._serialized_end=584
+  _METRICSVALUE._serialized_start=586
+  _METRICSVALUE._serialized_end=704
+  _LOGMETRICSREQUEST._serialized_start=706
+  _LOGMETRICSREQUEST._serialized_end=821
+  _LOGMETRICSRESPONSE._serialized_start=823
+  _LOGMETRICSRESPONSE._serialized_end=843
+  _GETMETRICSREQUEST._serialized_start=845
+  _GETMETRICSREQUEST._serialized_end=883
+  _GETMETRICSRESPONSE._serialized_start=886
+  _GETMETRICSRESPONSE._serialized_end=1033
+  _GETMETRICSRESPONSE_METRICSENTRY._serialized_start=968
+  _GETMETRICSRESPONSE_METRICSENTRY._serialized_end=1033
+  _TRACKARTIFACTSREQUEST._serialized_start=1035
+  _TRACKARTIFACTSREQUEST._serialized_end=1130
+  _TRACKARTIFACTSRESPONSE._serialized_start=1132
+  _TRACKARTIFACTSRESPONSE._serialized_end=1168
+  _LISTARTIFACTSREQUEST._serialized_start=1170
+  _LISTARTIFACTSREQUEST._serialized_end=1250
+  _LISTARTIFACTSRESPONSE._serialized_start=1252
+  _LISTARTIFACTSRESPONSE._serialized_end=1339
+  _FILEMETADATA._serialized_start=1342
+  _FILEMETADATA._serialized_end=1650
+  _DOWNLOADFILEREQUEST._serialized_start=1652
+  _DOWNLOADFILEREQUEST._serialized_end=1722
+  _DOWNLOADFILERESPONSE._serialized_start=1725
+  _DOWNLOADFILERESPONSE._serialized_end=1863
+  _UPLOADFILEREQUEST._serialized_start=1866
+  _UPLOADFILEREQUEST._serialized_end=2053
+  _FILECHUNK._serialized_start=2055
+  _FILECHUNK._serialized_end=2112
+  _UPLOADFILECOMMIT._serialized_start=2114
+  _UPLOADFILECOMMIT._serialized_end=2164
+  _UPLOADFILERESPONSE._serialized_start=2166
+  _UPLOADFILERESPONSE._serialized_end=2288
+  _UPLOADFILEMETADATA._serialized_start=2291
+  _UPLOADFILEMETADATA._serialized_end=2435
+  _UPLOADPART._serialized_start=2437
+  _UPLOADPART._serialized_end=2518
+  _COMPLETEUPLOADREQUEST._serialized_start=2521
+  _COMPLETEUPLOADREQUEST._serialized_end=2661
+  _ARTIFACT._serialized_start=2663
+  _ARTIFACT._serialized_end=2757
+  _MODEL._serialized_start=2760
+  _MODEL._serialized_end=2958
+  _CREATEMODELREQUEST._serialized_start=2961
+  _CREATEMODELREQUEST._serialized_end=3089
+  _CREATEMODELRESPONSE._serialized_start=3092
+  _CREATEMODELRESPONSE._serialized_end=3237
+  _MODELVERSION._serialized_start=3240
+  _MODELVERSION._serialized_end=3495
+  _CREATEMODELVERSIONREQUEST._serialized_start=3498
+  _CREATEMODELVERSIONREQUEST._serialized_end=3699
+  _CREATEMODELVERSIONRESPONSE._serialized_start=3702
+  _CREATEMODELVERSIONRESPONSE._serialized_end=3865
+  _EXPERIMENT._serialized_start=3868
+  _EXPERIMENT._serialized_end=4099
+  _CREATEEXPERIMENTREQUEST._serialized_start=4102
+  _CREATEEXPERIMENTREQUEST._serialized_end=4277
+  _CREATEEXPERIMENTRESPONSE._serialized_start=4280
+  _CREATEEXPERIMENTRESPONSE._serialized_end=4452
+  _SORTORDER._serialized_start=4454
+  _SORTORDER._serialized_end=4521
+  _TIMERANGE._serialized_start=4523
+  _TIMERANGE._serialized_end=4621
+  _LISTEXPERIMENTSREQUEST._serialized_start=4624
+  _LISTEXPERIMENTSREQUEST._serialized_end=5010
+  _LISTEXPERIMENTSREQUEST_METADATAENTRY._serialized_start=4963
+  _LISTEXPERIMENTSREQUEST_METADATAENTRY._serialized_end=5010
+  _LISTEXPERIMENTSRESPONSE._serialized_start=5012
+  _LISTEXPERIMENTSRESPONSE._serialized_end=5105
+  _SEARCHREQUEST._serialized_start=5107
+  _SEARCHREQUEST._serialized_end=5195
+  _METRICSUMMARY._serialized_start=5197
+  _METRICSUMMARY._serialized_end=5268
+  _SEARCHRESULT._serialized_start=5271
+  _SEARCHRESULT._serialized_end=5559
+  _SEARCHRESULT_METADATAENTRY._serialized_start=5439
+  _SEARCHRESULT_METADATAENTRY._serialized_end=5486
+  _SEARCHRESULT_METRICSENTRY._serialized_start=5488
+  _SEARCHRESULT_METRICSENTRY._serialized_end=5559
+  _SEARCHRESPONSE._serialized_start=5561
+  _SEARCHRESPONSE._serialized_end=5643
+  _LISTMODELVERSIONSREQUEST._serialized_start=5645
+  _LISTMODELVERSIONSREQUEST._serialized_end=5725
+  _LISTMODELVERSIONSRESPONSE._serialized_start=5727
+  _LISTMODELVERSIONSRESPONSE._serialized_end=5827
+  _LISTMODELSREQUEST._serialized_start=5830
+  _LISTMODELSREQUEST._serialized_end=6157
+  _LISTMODELSREQUEST_METADATAENTRY._serialized_start=6110
+  _LISTMODELSREQUEST_METADATAENTRY._serialized_end=6157
+  _LISTMODELSRESPONSE._serialized_start=6159
+  _LISTMODELSRESPONSE._serialized_end=6237
+  _METADATA._serialized_start=6239
+  _METADATA._serialized_end=6350
+  _METADATA_METADATAENTRY._serialized_start=6303
+  _METADATA_METADATAENTRY._serialized_end=6350
+  _UPDATEMETADATAREQUEST._serialized_start=6352
+  _UPDATEMETADATAREQUEST._serialized_end=6432
+  _UPDATEMETADATARESPONSE._serialized_start=6434
+  _UPDATEMETADATARESPONSE._serialized_end=6458
+  _LISTMETADATAREQUEST._serialized_start=6460
+  _LISTMETADATAREQUEST._serialized_end=6500
+  _LISTMETADATARESPONSE._serialized_start=6502
+  _LISTMETADATARESPONSE._serialized_end=6562
+  _EVENTSOURCE._serialized_start=6564
+  _EVENTSOURCE._serialized_end=6591
+  _EVENT._serialized_start=6594
+  _EVENT._serialized_end=6744
+  _LOGEVENTREQUEST._serialized_start=6746
+  _LOGEVENTREQUEST._serialized_end=6839
+  _LOGEVENTRESPONSE._serialized_start=6841
+  _LOGEVENTRESPONSE._serialized_end=6907
+  _LISTEVENTSREQUEST._serialized_start=6909
+  _LISTEVENTSREQUEST._serialized_end=7029
+  _LISTEVENTSRESPONSE._serialized_start=7031
+  _LISTEVENTSRESPONSE._serialized_end=7109
+  _GETEXPERIMENTREQUEST._serialized_start=7111
+  _GETEXPERIMENTREQUEST._serialized_end=7145
+  _GETEXPERIMENTRESPONSE._serialized_start=7147
+  _GETEXPERIMENTRESPONSE._serialized_end=7212
+  _MODELSTORE._serialized_start=7779
+  _MODELSTORE._serialized_end=9394
 # @@protoc_insertion_point(module_scope)
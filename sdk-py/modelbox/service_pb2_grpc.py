@@ -56,6 +56,11 @@ class ModelStoreStub(object):
                 request_serializer=service__pb2.UploadFileRequest.SerializeToString,
                 response_deserializer=service__pb2.UploadFileResponse.FromString,
                 )
+        self.CompleteUpload = channel.unary_unary(
+                '/modelbox.ModelStore/CompleteUpload',
+                request_serializer=service__pb2.CompleteUploadRequest.SerializeToString,
+                response_deserializer=service__pb2.UploadFileResponse.FromString,
+                )
         self.DownloadFile = channel.unary_stream(
                 '/modelbox.ModelStore/DownloadFile',
                 request_serializer=service__pb2.DownloadFileRequest.SerializeToString,
@@ -106,6 +111,11 @@ class ModelStoreStub(object):
                 request_serializer=service__pb2.WatchNamespaceRequest.SerializeToString,
                 response_deserializer=service__pb2.WatchNamespaceResponse.FromString,
                 )
+        self.Search = channel.unary_unary(
+                '/modelbox.ModelStore/Search',
+                request_serializer=service__pb2.SearchRequest.SerializeToString,
+                response_deserializer=service__pb2.SearchResponse.FromString,
+                )
 
 
 class ModelStoreServicer(object):
@@ -171,6 +181,13 @@ class ModelStoreServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def CompleteUpload(self, request, context):
+        """Assembles the parts of a multipart upload into a single file
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
     def DownloadFile(self, request, context):
         """DownloadFile downloads a file from configured storage
         """
@@ -243,6 +260,14 @@ class ModelStoreServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def Search(self, request, context):
+        """Search the experiments of a namespace with a query over their fields,
+        metadata and metrics.
+        """
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_ModelStoreServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -286,6 +311,11 @@ def add_ModelStoreServicer_to_server(servicer, server):
                     request_deserializer=service__pb2.UploadFileRequest.FromString,
                     response_serializer=service__pb2.UploadFileResponse.SerializeToString,
             ),
+            'CompleteUpload': grpc.unary_unary_rpc_method_handler(
+                    servicer.CompleteUpload,
+                    request_deserializer=service__pb2.CompleteUploadRequest.FromString,
+                    response_serializer=service__pb2.UploadFileResponse.SerializeToString,
+            ),
             'DownloadFile': grpc.unary_stream_rpc_method_handler(
                     servicer.DownloadFile,
                     request_deserializer=service__pb2.DownloadFileRequest.FromString,
@@ -336,6 +366,11 @@ def add_ModelStoreServicer_to_server(servicer, server):
                     request_deserializer=service__pb2.WatchNamespaceRequest.FromString,
                     response_serializer=service__pb2.WatchNamespaceResponse.SerializeToString,
             ),
+            'Search': grpc.unary_unary_rpc_method_handler(
+                    servicer.Search,
+                    request_deserializer=service__pb2.SearchRequest.FromString,
+                    response_serializer=service__pb2.SearchResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'modelbox.ModelStore', rpc_method_handlers)
@@ -485,6 +520,23 @@ class ModelStore(object):
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
 
+    @staticmethod
+    def CompleteUpload(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/modelbox.ModelStore/CompleteUpload',
+            service__pb2.CompleteUploadRequest.SerializeToString,
+            service__pb2.UploadFileResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
     @staticmethod
     def DownloadFile(request,
             target,
@@ -654,3 +706,20 @@ class ModelStore(object):
             service__pb2.WatchNamespaceResponse.FromString,
             options, channel_credentials,
             insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
+
+    @staticmethod
+    def Search(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(request, target, '/modelbox.ModelStore/Search',
+            service__pb2.SearchRequest.SerializeToString,
+            service__pb2.SearchResponse.FromString,
+            options, channel_credentials,
+            insecure, call_credentials, compression, wait_for_ready, timeout, metadata)
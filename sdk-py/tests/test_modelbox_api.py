@@ -314,6 +314,14 @@ class TestModelBoxApi(unittest.TestCase):
             f = LocalFile.from_path('./tests/test_artifact.txt')
         except Exception as ex:
             self.fail(ex)
+        self.assertEqual(f.checksum_algorithm, service_pb2.SHA256)
+
+    def test_file_checksum_algorithm(self):
+        self.assertEqual(LocalFile("a.pt", "abc").checksum_algorithm, service_pb2.MD5)
+        f = LocalFile("a.pt", "abc", checksum_algorithm=service_pb2.BLAKE3)
+        self.assertEqual(f.checksum_algorithm, service_pb2.BLAKE3)
+        f = LocalFile.from_path("./tests/test_artifact.txt", "abc")
+        self.assertEqual(f.checksum_algorithm, service_pb2.MD5)
 
     def _create_model(self):
         model = self.mbox.new_model(
@@ -0,0 +1,252 @@
+package server
+
+import (
+	"context"
+	"hash/fnv"
+	"strconv"
+	"time"
+
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"github.com/tensorland/modelbox/server/storage"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// objectId derives the id of an object from the fields identifying it, so
+// that creating an object twice returns the id of the existing object.
+func objectId(fields ...string) string {
+	h := fnv.New64a()
+	for _, f := range fields {
+		h.Write([]byte(f))
+		// separates fields so that ("ab", "c") and ("a", "bc") differ
+		h.Write([]byte{0})
+	}
+	return strconv.FormatUint(h.Sum64(), 10)
+}
+
+func (s *Server) CreateExperiment(ctx context.Context, req *proto.CreateExperimentRequest) (*proto.CreateExperimentResponse, error) {
+	if err := validate("experiments need a name and a namespace",
+		field{"name", req.Name != ""}, field{"namespace", req.Namespace != ""}); err != nil {
+		return nil, err
+	}
+	e, exists, err := s.store.CreateExperiment(ctx, &proto.Experiment{
+		Id:         objectId(req.Name, req.Owner, req.Namespace),
+		Name:       req.Name,
+		Namespace:  req.Namespace,
+		Owner:      req.Owner,
+		Framework:  req.Framework,
+		ExternalId: req.ExternalId,
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if !exists {
+		s.notify()
+	}
+	return &proto.CreateExperimentResponse{
+		ExperimentId:     e.Id,
+		ExperimentExists: exists,
+		CreatedAt:        e.CreatedAt,
+		UpdatedAt:        e.UpdatedAt,
+	}, nil
+}
+
+func (s *Server) ListExperiments(ctx context.Context, req *proto.ListExperimentsRequest) (*proto.ListExperimentsResponse, error) {
+	page, err := sortedPage(req.PageSize, req.PageToken, req.Sort)
+	if err != nil {
+		return nil, err
+	}
+	filter := &storage.Filter{
+		Owner:      req.Owner,
+		Framework:  req.Framework,
+		ExternalId: req.ExternalId,
+		Metadata:   req.Metadata,
+	}
+	filter.CreatedAfter, filter.CreatedBefore = timeRange(req.Created)
+	filter.UpdatedAfter, filter.UpdatedBefore = timeRange(req.Updated)
+	experiments, next, err := s.store.ListExperiments(ctx, req.Namespace, filter, page)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto.ListExperimentsResponse{Experiments: experiments, NextPageToken: pageToken(next)}, nil
+}
+
+func (s *Server) GetExperiment(ctx context.Context, req *proto.GetExperimentRequest) (*proto.GetExperimentResponse, error) {
+	e, err := s.store.GetExperiment(ctx, req.Id)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto.GetExperimentResponse{Experiment: e}, nil
+}
+
+func (s *Server) CreateModel(ctx context.Context, req *proto.CreateModelRequest) (*proto.CreateModelResponse, error) {
+	if err := validate("models need a name and a namespace",
+		field{"name", req.Name != ""}, field{"namespace", req.Namespace != ""}); err != nil {
+		return nil, err
+	}
+	m, exists, err := s.store.CreateModel(ctx, &proto.Model{
+		Id:          objectId(req.Name, req.Namespace),
+		Name:        req.Name,
+		Owner:       req.Owner,
+		Namespace:   req.Namespace,
+		Task:        req.Task,
+		Description: req.Description,
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if !exists {
+		s.notify()
+	}
+	return &proto.CreateModelResponse{Id: m.Id, Exists: exists, CreatedAt: m.CreatedAt, UpdatedAt: m.UpdatedAt}, nil
+}
+
+func (s *Server) ListModels(ctx context.Context, req *proto.ListModelsRequest) (*proto.ListModelsResponse, error) {
+	page, err := sortedPage(req.PageSize, req.PageToken, req.Sort)
+	if err != nil {
+		return nil, err
+	}
+	filter := &storage.Filter{Owner: req.Owner, Task: req.Task, Metadata: req.Metadata}
+	filter.CreatedAfter, filter.CreatedBefore = timeRange(req.Created)
+	filter.UpdatedAfter, filter.UpdatedBefore = timeRange(req.Updated)
+	models, next, err := s.store.ListModels(ctx, req.Namespace, filter, page)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto.ListModelsResponse{Models: models, NextPageToken: pageToken(next)}, nil
+}
+
+func (s *Server) CreateModelVersion(ctx context.Context, req *proto.CreateModelVersionRequest) (*proto.CreateModelVersionResponse, error) {
+	if err := validate("model versions need a model and a version",
+		field{"model", req.Model != ""}, field{"version", req.Version != ""}); err != nil {
+		return nil, err
+	}
+	v, exists, err := s.store.CreateModelVersion(ctx, &proto.ModelVersion{
+		Id:          objectId(req.Model, req.Version),
+		ModelId:     req.Model,
+		Name:        req.Name,
+		Version:     req.Version,
+		Description: req.Description,
+		Framework:   req.Framework,
+		UniqueTags:  req.UniqueTags,
+	}, req.Namespace)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if !exists {
+		s.notify()
+	}
+	return &proto.CreateModelVersionResponse{ModelVersion: v.Id, Exists: exists, CreatedAt: v.CreatedAt, UpdatedAt: v.UpdatedAt}, nil
+}
+
+func (s *Server) ListModelVersions(ctx context.Context, req *proto.ListModelVersionsRequest) (*proto.ListModelVersionsResponse, error) {
+	page, err := requestedPage(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	versions, next, err := s.store.ListModelVersions(ctx, req.Model, page)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto.ListModelVersionsResponse{ModelVersions: versions, NextPageToken: pageToken(next)}, nil
+}
+
+func (s *Server) UpdateMetadata(ctx context.Context, req *proto.UpdateMetadataRequest) (*proto.UpdateMetadataResponse, error) {
+	if err := validate("metadata needs a parent id", field{"parent_id", req.ParentId != ""}); err != nil {
+		return nil, err
+	}
+	if err := s.store.UpdateMetadata(ctx, req.ParentId, req.GetMetadata().GetMetadata()); err != nil {
+		return nil, toStatus(err)
+	}
+	s.notify()
+	return &proto.UpdateMetadataResponse{}, nil
+}
+
+func (s *Server) ListMetadata(ctx context.Context, req *proto.ListMetadataRequest) (*proto.ListMetadataResponse, error) {
+	meta, err := s.store.ListMetadata(ctx, req.ParentId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto.ListMetadataResponse{Metadata: &proto.Metadata{Metadata: meta}}, nil
+}
+
+func (s *Server) LogMetrics(ctx context.Context, req *proto.LogMetricsRequest) (*proto.LogMetricsResponse, error) {
+	if err := validate("metrics need a parent id, a key and a value",
+		field{"parent_id", req.ParentId != ""}, field{"key", req.Key != ""}, field{"value", req.Value != nil}); err != nil {
+		return nil, err
+	}
+	if err := s.store.LogMetric(ctx, req.ParentId, req.Key, req.Value, req.IdempotencyKey); err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto.LogMetricsResponse{}, nil
+}
+
+func (s *Server) GetMetrics(ctx context.Context, req *proto.GetMetricsRequest) (*proto.GetMetricsResponse, error) {
+	metrics, err := s.store.GetMetrics(ctx, req.ParentId)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto.GetMetricsResponse{Metrics: metrics}, nil
+}
+
+func (s *Server) LogEvent(ctx context.Context, req *proto.LogEventRequest) (*proto.LogEventResponse, error) {
+	if err := validate("events need a parent id and an event",
+		field{"parent_id", req.ParentId != ""}, field{"event", req.Event != nil}); err != nil {
+		return nil, err
+	}
+	createdAt, err := s.store.LogEvent(ctx, req.ParentId, req.Event, req.IdempotencyKey)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto.LogEventResponse{CreatedAt: timestamppb.New(createdAt)}, nil
+}
+
+func (s *Server) ListEvents(ctx context.Context, req *proto.ListEventsRequest) (*proto.ListEventsResponse, error) {
+	page, err := requestedPage(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	var since time.Time
+	if req.Since != nil {
+		since = req.Since.AsTime()
+	}
+	events, next, err := s.store.ListEvents(ctx, req.ParentId, since, page)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto.ListEventsResponse{Events: events, NextPageToken: pageToken(next)}, nil
+}
+
+// TrackArtifacts records files stored outside of ModelBox as an artifact of
+// an object. Tracking an artifact again adds its files to the artifact.
+func (s *Server) TrackArtifacts(ctx context.Context, req *proto.TrackArtifactsRequest) (*proto.TrackArtifactsResponse, error) {
+	if err := validate("artifacts need a name and an object id",
+		field{"name", req.Name != ""}, field{"object_id", req.ObjectId != ""}); err != nil {
+		return nil, err
+	}
+	for _, f := range req.Files {
+		if f.ParentId == "" {
+			f.ParentId = req.ObjectId
+		}
+		if f.Id == "" {
+			f.Id = objectId(f.ParentId, f.SrcPath, f.Checksum, f.FileType.String())
+		}
+	}
+	a, err := s.store.AddFiles(ctx, objectId(req.ObjectId, req.Name), req.Name, req.ObjectId, req.Files)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.notify()
+	return &proto.TrackArtifactsResponse{Id: a.Id}, nil
+}
+
+func (s *Server) ListArtifacts(ctx context.Context, req *proto.ListArtifactsRequest) (*proto.ListArtifactsResponse, error) {
+	page, err := requestedPage(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	artifacts, next, err := s.store.ListArtifacts(ctx, req.ObjectId, page)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto.ListArtifactsResponse{Artifacts: artifacts, NextPageToken: pageToken(next)}, nil
+}
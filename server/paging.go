@@ -0,0 +1,60 @@
+package server
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"github.com/tensorland/modelbox/server/storage"
+)
+
+// pageToken encodes the cursor of the next page of a listing, listings
+// without a next page end with an empty token.
+func pageToken(next *storage.Cursor) string {
+	if next == nil {
+		return ""
+	}
+	b, _ := json.Marshal([]string{next.Key, next.Id})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// requestedPage returns the page of a listing selected by the paging fields
+// of a request.
+func requestedPage(size uint32, token string) (storage.Page, error) {
+	p := storage.Page{Size: int(size)}
+	if token == "" {
+		return p, nil
+	}
+	invalid := validate("invalid page token", field{"page_token", false})
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return p, invalid
+	}
+	var cursor []string
+	if err := json.Unmarshal(b, &cursor); err != nil || len(cursor) != 2 {
+		return p, invalid
+	}
+	p.After = &storage.Cursor{Key: cursor[0], Id: cursor[1]}
+	return p, nil
+}
+
+// sortedPage returns the page of a sorted listing selected by a request.
+func sortedPage(size uint32, token string, sort *proto.SortOrder) (storage.Page, error) {
+	p, err := requestedPage(size, token)
+	p.OrderBy = sort.GetField()
+	p.Descending = sort.GetDescending()
+	return p, err
+}
+
+// timeRange returns the bounds of a range, unset bounds are zero.
+func timeRange(r *proto.TimeRange) (time.Time, time.Time) {
+	var after, before time.Time
+	if r.GetAfter() != nil {
+		after = r.After.AsTime()
+	}
+	if r.GetBefore() != nil {
+		before = r.Before.AsTime()
+	}
+	return after, before
+}
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"github.com/tensorland/modelbox/sdk-go/search"
+	"github.com/tensorland/modelbox/server/storage"
+)
+
+// searchBatchSize is how many experiments are loaded at a time while
+// evaluating a search.
+const searchBatchSize = 100
+
+// Search evaluates the query against the experiments of the namespace in
+// the order they were created. Pages end after page_size matches, and the
+// page token is the cursor of the last experiment evaluated.
+func (s *Server) Search(ctx context.Context, req *proto.SearchRequest) (*proto.SearchResponse, error) {
+	if err := validate("searches need a namespace", field{"namespace", req.Namespace != ""}); err != nil {
+		return nil, err
+	}
+	q, err := search.Parse(req.Query)
+	if err != nil {
+		return nil, validate(err.Error(), field{"query", false})
+	}
+	page, err := requestedPage(req.PageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	batch := storage.Page{Size: searchBatchSize, After: page.After}
+	resp := &proto.SearchResponse{Results: []*proto.SearchResult{}}
+	for {
+		experiments, next, err := s.store.ListExperiments(ctx, req.Namespace, &storage.Filter{}, batch)
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		for i, e := range experiments {
+			r, err := s.searchRecord(ctx, q, e)
+			if err != nil {
+				return nil, toStatus(err)
+			}
+			if q.Match(r) {
+				resp.Results = append(resp.Results, q.Result(r))
+			}
+			if page.Size > 0 && len(resp.Results) == page.Size {
+				if i < len(experiments)-1 || next != nil {
+					resp.NextPageToken = pageToken(&storage.Cursor{
+						Key: strconv.FormatInt(e.CreatedAt.AsTime().UnixNano(), 10),
+						Id:  e.Id,
+					})
+				}
+				return resp, nil
+			}
+		}
+		if next == nil {
+			return resp, nil
+		}
+		batch.After = next
+	}
+}
+
+// searchRecord loads the metadata of an experiment, and the metrics if the
+// query refers to any.
+func (s *Server) searchRecord(ctx context.Context, q *search.Query, e *proto.Experiment) (*search.Record, error) {
+	metadata, err := s.store.ListMetadata(ctx, e.Id)
+	if err != nil {
+		return nil, err
+	}
+	r := &search.Record{Experiment: e, Metadata: metadata}
+	if len(q.Metrics()) > 0 {
+		if r.Metrics, err = s.store.GetMetrics(ctx, e.Id); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
@@ -0,0 +1,215 @@
+// Package server is an embedded ModelBox server written in Go. It implements
+// the ModelStore service, wire-compatible with the Rust server, on top of a
+// SQLite database and a directory of the local filesystem, so that ModelBox
+// runs on laptops and in CI without Postgres or an object store.
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"github.com/tensorland/modelbox/server/storage"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DEFAULT_GRPC_LISTEN_ADDR = "127.0.0.1:8085"
+
+	// DEFAULT_DOWNLOAD_CHUNK_SIZE is the size of the chunks files are
+	// downloaded in.
+	DEFAULT_DOWNLOAD_CHUNK_SIZE = 1 << 20
+
+	// watchBatchSize is the number of changes read at once by watches.
+	watchBatchSize = 100
+)
+
+// Config configures the embedded server.
+type Config struct {
+	GrpcListenAddr string
+	// HTTPListenAddr serves the health endpoint when set.
+	HTTPListenAddr string
+	// DataDir holds the database and the files, it is created if needed.
+	DataDir string
+}
+
+// Server serves the ModelStore service from a SQLite database and a blob
+// directory.
+type Server struct {
+	proto.UnimplementedModelStoreServer
+
+	config *Config
+	store  *storage.Store
+	blobs  *storage.BlobStore
+	logger *zap.Logger
+
+	// uploadLocks serializes writes to each staged upload
+	uploadLocks keyLocks
+
+	mu sync.Mutex
+	// changed is closed and replaced whenever changes are recorded
+	changed chan struct{}
+}
+
+// New opens the database and the blob directory of the server.
+func New(config *Config, logger *zap.Logger) (*Server, error) {
+	if config.DataDir == "" {
+		return nil, errors.New("the embedded server needs a data directory")
+	}
+	blobs, err := storage.NewBlobStore(filepath.Join(config.DataDir, "blobs"))
+	if err != nil {
+		return nil, err
+	}
+	store, err := storage.Open(filepath.Join(config.DataDir, "modelbox.db"))
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		config:  config,
+		store:   store,
+		blobs:   blobs,
+		logger:  logger,
+		changed: make(chan struct{}),
+	}, nil
+}
+
+// Close closes the database.
+func (s *Server) Close() error {
+	return s.store.Close()
+}
+
+// Register registers the services of the server with g.
+func (s *Server) Register(g *grpc.Server) {
+	proto.RegisterModelStoreServer(g, s)
+}
+
+// ListenAndServe serves the services on the addresses of the config until
+// ctx is canceled, at which point running calls are given some time to
+// finish.
+func (s *Server) ListenAndServe(ctx context.Context) error {
+	addr := s.config.GrpcListenAddr
+	if addr == "" {
+		addr = DEFAULT_GRPC_LISTEN_ADDR
+	}
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("unable to listen on %v: %v", addr, err)
+	}
+	if s.config.HTTPListenAddr != "" {
+		httpServer := &http.Server{Addr: s.config.HTTPListenAddr, Handler: s.httpHandler()}
+		go func() {
+			s.logger.Info("serving http", zap.String("addr", s.config.HTTPListenAddr))
+			if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				s.logger.Error("http server failed", zap.Error(err))
+			}
+		}()
+		defer httpServer.Close()
+	}
+	s.logger.Info("serving grpc", zap.String("addr", lis.Addr().String()), zap.String("data_dir", s.config.DataDir))
+	return s.Serve(ctx, lis)
+}
+
+// Serve serves the services on lis until ctx is canceled.
+func (s *Server) Serve(ctx context.Context, lis net.Listener) error {
+	g := grpc.NewServer()
+	s.Register(g)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-done:
+			return
+		}
+		// watches only end with their clients, stop them after a while
+		timer := time.AfterFunc(10*time.Second, g.Stop)
+		defer timer.Stop()
+		g.GracefulStop()
+	}()
+	return g.Serve(lis)
+}
+
+// httpHandler serves /healthz, which fails when the database can't be
+// queried.
+func (s *Server) httpHandler() http.Handler {
+	r := chi.NewRouter()
+	r.Get("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		if err := s.store.Ping(r.Context()); err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok\n"))
+	})
+	return r
+}
+
+// notify wakes up the watches after changes were recorded.
+func (s *Server) notify() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// changes returns a channel which is closed on the next change.
+func (s *Server) changes() <-chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.changed
+}
+
+// field is a field of a request, and whether it is valid.
+type field struct {
+	name  string
+	valid bool
+}
+
+// validate returns an InvalidArgument error with message, detailing the
+// invalid fields, or nil if every field is valid.
+func validate(message string, fields ...field) error {
+	badRequest := &errdetails.BadRequest{}
+	for _, f := range fields {
+		if !f.valid {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       f.name,
+				Description: message,
+			})
+		}
+	}
+	if len(badRequest.FieldViolations) == 0 {
+		return nil
+	}
+	st, err := status.New(codes.InvalidArgument, message).WithDetails(badRequest)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, message)
+	}
+	return st.Err()
+}
+
+// toStatus converts errors of the store to gRPC errors.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, storage.ErrNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+	return status.Error(codes.Internal, err.Error())
+}
@@ -0,0 +1,448 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	client "github.com/tensorland/modelbox/sdk-go"
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// startServer serves the data directory over an in-memory connection until
+// the test ends, and returns the dial option connecting to it.
+func startServer(t *testing.T, dataDir string) grpc.DialOption {
+	s, err := New(&Config{DataDir: dataDir}, zap.NewNop())
+	assert.Nil(t, err)
+	lis := bufconn.Listen(1 << 20)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		s.Serve(ctx, lis)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+		s.Close()
+	})
+	dialer := func(ctx context.Context, addr string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}
+	return grpc.WithContextDialer(dialer)
+}
+
+func newTestClient(t *testing.T, dataDir string, opts ...client.ClientOption) *client.ModelBoxClient {
+	dialer := startServer(t, dataDir)
+	mb, err := client.NewModelBoxClient("bufnet", append(opts, client.WithDialOptions(dialer))...)
+	assert.Nil(t, err)
+	t.Cleanup(func() { mb.Close() })
+	return mb
+}
+
+func TestCreateIsIdempotent(t *testing.T) {
+	dataDir := t.TempDir()
+	mb := newTestClient(t, dataDir)
+	ctx := context.Background()
+
+	exp, err := mb.CreateExperiment(ctx, "bert", "owner@email", "langtech", "ext-1", "pytorch")
+	assert.Nil(t, err)
+	assert.False(t, exp.Exists)
+	again, err := mb.CreateExperiment(ctx, "bert", "owner@email", "langtech", "ext-1", "pytorch")
+	assert.Nil(t, err)
+	assert.True(t, again.Exists)
+	assert.Equal(t, exp.Id, again.Id)
+	assert.Equal(t, exp.CreatedAt, again.CreatedAt)
+
+	model, err := mb.CreateModel(ctx, "bert-base", "owner@email", "langtech", "nlp", "")
+	assert.Nil(t, err)
+	_, err = mb.CreateModelVersion(ctx, model.Id, "v1", "1", "", "langtech", "pytorch", []string{"prod"})
+	assert.Nil(t, err)
+	version, err := mb.CreateModelVersion(ctx, model.Id, "v1", "1", "", "langtech", "pytorch", []string{"prod"})
+	assert.Nil(t, err)
+	assert.True(t, version.Exists)
+	_, err = mb.CreateModelVersion(ctx, "missing", "v1", "1", "", "langtech", "pytorch", nil)
+	assert.ErrorIs(t, err, client.ErrNotFound)
+
+	assert.Nil(t, mb.UpdateMetadata(ctx, exp.Id, map[string]interface{}{"lr": 0.1, "layers": 2.0}))
+	assert.Nil(t, mb.UpdateMetadata(ctx, exp.Id, map[string]interface{}{"lr": 0.01}))
+	for step := uint64(1); step <= 3; step++ {
+		assert.Nil(t, mb.LogMetrics(ctx, exp.Id, "loss", &client.MetricValue{Step: step, Value: float32(step)}))
+	}
+
+	// everything is read back from the database by a new server
+	mb = newTestClient(t, dataDir)
+	experiments, err := mb.ListExperiments(ctx, "langtech")
+	assert.Nil(t, err)
+	assert.Len(t, experiments, 1)
+	assert.Equal(t, "ext-1", experiments[0].ExternalId)
+	versions, err := mb.ListModelVersions(ctx, model.Id)
+	assert.Nil(t, err)
+	assert.Len(t, versions, 1)
+	assert.Equal(t, []string{"prod"}, versions[0].UniqueTags)
+	meta, err := mb.ListMetadata(ctx, exp.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"lr": 0.01, "layers": 2.0}, meta)
+	metrics, err := mb.GetMetrics(ctx, exp.Id)
+	assert.Nil(t, err)
+	assert.Len(t, metrics["loss"], 3)
+	assert.Equal(t, uint64(3), metrics["loss"][2].Step)
+
+	_, err = mb.GetExperiment(ctx, "missing")
+	assert.ErrorIs(t, err, client.ErrNotFound)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = mb.CreateExperiment(ctx, "", "owner@email", "langtech", "", "pytorch")
+	assert.ErrorIs(t, err, client.ErrInvalidArgument)
+	var statusErr *client.StatusError
+	assert.True(t, errors.As(err, &statusErr))
+	assert.Equal(t, "name", statusErr.FieldViolations()[0].Field)
+}
+
+func TestLogIsIdempotent(t *testing.T) {
+	conn, err := grpc.Dial("bufnet", startServer(t, t.TempDir()), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Nil(t, err)
+	defer conn.Close()
+	store := proto.NewModelStoreClient(conn)
+	ctx := context.Background()
+
+	// retries of a call carry the same key
+	for _, key := range []string{"key-1", "key-1", "key-2", "", ""} {
+		_, err := store.LogMetrics(ctx, &proto.LogMetricsRequest{
+			ParentId:       "exp-1",
+			Key:            "loss",
+			Value:          &proto.MetricsValue{Step: 1, Value: &proto.MetricsValue_FVal{FVal: 0.5}},
+			IdempotencyKey: key,
+		})
+		assert.Nil(t, err)
+		_, err = store.LogEvent(ctx, &proto.LogEventRequest{
+			ParentId:       "exp-1",
+			Event:          &proto.Event{Name: "checkpoint", Source: &proto.EventSource{Name: "trainer"}},
+			IdempotencyKey: key,
+		})
+		assert.Nil(t, err)
+	}
+	metrics, err := store.GetMetrics(ctx, &proto.GetMetricsRequest{ParentId: "exp-1"})
+	assert.Nil(t, err)
+	assert.Len(t, metrics.Metrics["loss"].Values, 4)
+	events, err := store.ListEvents(ctx, &proto.ListEventsRequest{ParentId: "exp-1"})
+	assert.Nil(t, err)
+	assert.Len(t, events.Events, 4)
+}
+
+func TestPaging(t *testing.T) {
+	mb := newTestClient(t, t.TempDir(), client.WithPageSize(2))
+	ctx := context.Background()
+
+	var ids []string
+	for i := 0; i < 5; i++ {
+		exp, err := mb.CreateExperiment(ctx, fmt.Sprintf("bert-%v", i), "owner@email", "langtech", "", "pytorch")
+		assert.Nil(t, err)
+		ids = append(ids, exp.Id)
+		// events logged at the same time are ordered by when they were logged
+		_, err = mb.LogEvent(ctx, ids[0], &client.Event{Name: fmt.Sprint(i), Source: "trainer", WallclockTime: time.Unix(100, 0)})
+		assert.Nil(t, err)
+	}
+	it := mb.Experiments(ctx, "langtech")
+	for _, id := range ids {
+		exp, err := it.Next()
+		assert.Nil(t, err)
+		assert.Equal(t, id, exp.Id)
+	}
+	_, err := it.Next()
+	assert.Equal(t, client.ErrIteratorDone, err)
+	events, err := mb.ListEvents(ctx, ids[0], time.Time{})
+	assert.Nil(t, err)
+	assert.Len(t, events, 5)
+	for i, e := range events {
+		assert.Equal(t, fmt.Sprint(i), e.Name)
+	}
+
+	conn, err := grpc.Dial("bufnet", startServer(t, t.TempDir()), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Nil(t, err)
+	defer conn.Close()
+	_, err = proto.NewModelStoreClient(conn).ListModels(ctx, &proto.ListModelsRequest{Namespace: "langtech", PageToken: "not a token"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQuery(t *testing.T) {
+	mb := newTestClient(t, t.TempDir(), client.WithPageSize(2))
+	ctx := context.Background()
+
+	owners := []string{"alice", "bob", "alice", "alice", "bob"}
+	var ids []string
+	for i, owner := range owners {
+		framework := "pytorch"
+		if i == 3 {
+			framework = "keras"
+		}
+		exp, err := mb.CreateExperiment(ctx, fmt.Sprintf("bert-%v", i), owner, "langtech", "", framework)
+		assert.Nil(t, err)
+		ids = append(ids, exp.Id)
+		assert.Nil(t, mb.UpdateMetadata(ctx, exp.Id, map[string]interface{}{"lr": 0.1 * float64(i%2)}))
+	}
+	names := func(experiments []*client.Experiment) []string {
+		var names []string
+		for _, e := range experiments {
+			names = append(names, e.Name)
+		}
+		return names
+	}
+
+	experiments, err := mb.QueryExperiments("langtech").Owner("alice").OrderByDesc(client.SortByName).All(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"bert-3", "bert-2", "bert-0"}, names(experiments))
+	experiments, err = mb.QueryExperiments("langtech").Owner("alice").Framework("pytorch").All(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"bert-0", "bert-2"}, names(experiments))
+	experiments, err = mb.QueryExperiments("langtech").Metadata("lr", 0.1).OrderBy(client.SortByName).All(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"bert-1", "bert-3"}, names(experiments))
+
+	// experiments created at or after the third one
+	third, err := mb.GetExperiment(ctx, ids[2])
+	assert.Nil(t, err)
+	experiments, err = mb.QueryExperiments("langtech").CreatedBetween(third.CreatedAt, time.Time{}).All(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"bert-2", "bert-3", "bert-4"}, names(experiments))
+	experiments, err = mb.QueryExperiments("langtech").CreatedBetween(time.Time{}, third.CreatedAt).OrderByDesc(client.SortByCreatedAt).All(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"bert-1", "bert-0"}, names(experiments))
+
+	_, err = mb.CreateModel(ctx, "resnet", "alice", "langtech", "vision", "")
+	assert.Nil(t, err)
+	_, err = mb.CreateModel(ctx, "bert-base", "alice", "langtech", "nlp", "")
+	assert.Nil(t, err)
+	models, err := mb.QueryModels("langtech").Task("nlp").All(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, models, 1)
+	assert.Equal(t, "bert-base", models[0].Name)
+}
+
+func TestSearch(t *testing.T) {
+	mb := newTestClient(t, t.TempDir(), client.WithPageSize(1))
+	ctx := context.Background()
+
+	for i := 0; i < 4; i++ {
+		exp, err := mb.CreateExperiment(ctx, fmt.Sprintf("bert-%v", i), "alice", "langtech", "", "pytorch")
+		assert.Nil(t, err)
+		assert.Nil(t, mb.UpdateMetadata(ctx, exp.Id, map[string]interface{}{"optimizer": []string{"sgd", "adam"}[i%2]}))
+		for step := 0; step <= i; step++ {
+			assert.Nil(t, mb.LogMetrics(ctx, exp.Id, "loss", &client.MetricValue{Step: uint64(step), Value: 1 / float32(step+1)}))
+		}
+	}
+	names := func(results []*client.SearchResult) []string {
+		var names []string
+		for _, r := range results {
+			names = append(names, r.Experiment.Name)
+		}
+		return names
+	}
+
+	results, err := mb.Search(ctx, "langtech", "metadata.optimizer = 'adam' and metrics.loss < 0.5")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"bert-3"}, names(results))
+	assert.Equal(t, &client.MetricSummary{Latest: 0.25, Step: 3, Min: 0.25, Max: 1}, results[0].Metrics["loss"])
+	results, err = mb.Search(ctx, "langtech", "not (name = 'bert-0' or name = 'bert-2') or metadata.missing = 1")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"bert-1", "bert-3"}, names(results))
+	assert.Empty(t, results[0].Metrics)
+	results, err = mb.Search(ctx, "other", "owner = 'alice'")
+	assert.Nil(t, err)
+	assert.Empty(t, results)
+
+	// queries are validated by the server too
+	conn, err := grpc.Dial("bufnet", startServer(t, t.TempDir()), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Nil(t, err)
+	defer conn.Close()
+	_, err = proto.NewModelStoreClient(conn).Search(ctx, &proto.SearchRequest{Namespace: "langtech", Query: "lr < 1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUploadResumes(t *testing.T) {
+	dialer := startServer(t, t.TempDir())
+	conn, err := grpc.Dial("bufnet", dialer, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Nil(t, err)
+	defer conn.Close()
+	store := proto.NewModelStoreClient(conn)
+	ctx := context.Background()
+	data := bytes.Repeat([]byte("modelbox"), 1024)
+	meta := &proto.UploadFileRequest{StreamFrame: &proto.UploadFileRequest_Metadata{Metadata: &proto.UploadFileMetadata{
+		UploadId:     "upload-1",
+		ObjectId:     "exp-1",
+		ArtifactName: "weights",
+		Metadata: &proto.FileMetadata{
+			SrcPath:           "model.pt",
+			FileType:          proto.FileType_MODEL,
+			ChecksumAlgorithm: client.ChecksumBLAKE3.ToProto(),
+		},
+	}}}
+	chunk := func(offset int, b []byte) *proto.UploadFileRequest {
+		return &proto.UploadFileRequest{StreamFrame: &proto.UploadFileRequest_Chunk{Chunk: &proto.FileChunk{
+			Offset: uint64(offset), Data: b, Crc32C: crc32.Checksum(b, crc32c),
+		}}}
+	}
+
+	// the first stream ends after half of the file
+	stream, err := store.UploadFile(ctx)
+	assert.Nil(t, err)
+	assert.Nil(t, stream.Send(meta))
+	assert.Nil(t, stream.Send(chunk(0, data[:4096])))
+	resp, err := stream.CloseAndRecv()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(4096), resp.CommittedOffset)
+	assert.False(t, resp.Completed)
+
+	// chunks at the wrong offset are rejected
+	stream, err = store.UploadFile(ctx)
+	assert.Nil(t, err)
+	assert.Nil(t, stream.Send(meta))
+	assert.Nil(t, stream.Send(chunk(0, data[:4096])))
+	_, err = stream.CloseAndRecv()
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+
+	h := client.ChecksumBLAKE3.New()
+	h.Write(data)
+	stream, err = store.UploadFile(ctx)
+	assert.Nil(t, err)
+	assert.Nil(t, stream.Send(meta))
+	assert.Nil(t, stream.Send(chunk(4096, data[4096:])))
+	assert.Nil(t, stream.Send(&proto.UploadFileRequest{StreamFrame: &proto.UploadFileRequest_Commit{Commit: &proto.UploadFileCommit{
+		Size: uint64(len(data)), Checksum: hex.EncodeToString(h.Sum(nil)),
+	}}}))
+	resp, err = stream.CloseAndRecv()
+	assert.Nil(t, err)
+	assert.True(t, resp.Completed)
+	assert.NotEmpty(t, resp.FileId)
+
+	mb, err := client.NewModelBoxClient("bufnet", client.WithDialOptions(dialer))
+	assert.Nil(t, err)
+	defer mb.Close()
+	r, fileMeta, err := mb.Download(ctx, resp.FileId)
+	assert.Nil(t, err)
+	defer r.Close()
+	assert.Equal(t, uint64(len(data)), fileMeta.Size)
+	downloaded := new(bytes.Buffer)
+	_, err = downloaded.ReadFrom(r)
+	assert.Nil(t, err)
+	assert.Equal(t, data, downloaded.Bytes())
+}
+
+func TestLegacyUploadChecksum(t *testing.T) {
+	dialer := startServer(t, t.TempDir())
+	conn, err := grpc.Dial("bufnet", dialer, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Nil(t, err)
+	defer conn.Close()
+	store := proto.NewModelStoreClient(conn)
+	data := bytes.Repeat([]byte("modelbox"), 1024)
+	h := client.ChecksumMD5.New()
+	h.Write(data)
+	upload := func(checksum string) (*proto.UploadFileResponse, error) {
+		stream, err := store.UploadFile(context.Background())
+		assert.Nil(t, err)
+		assert.Nil(t, stream.Send(&proto.UploadFileRequest{StreamFrame: &proto.UploadFileRequest_Metadata{Metadata: &proto.UploadFileMetadata{
+			ObjectId:     "exp-1",
+			ArtifactName: "weights",
+			Metadata: &proto.FileMetadata{
+				SrcPath:           "model.pt",
+				Checksum:          checksum,
+				ChecksumAlgorithm: client.ChecksumMD5.ToProto(),
+			},
+		}}}))
+		assert.Nil(t, stream.Send(&proto.UploadFileRequest{StreamFrame: &proto.UploadFileRequest_Chunks{Chunks: data}}))
+		return stream.CloseAndRecv()
+	}
+
+	_, err = upload("0123")
+	assert.Equal(t, codes.DataLoss, status.Code(err))
+	resp, err := upload(hex.EncodeToString(h.Sum(nil)))
+	assert.Nil(t, err)
+	assert.True(t, resp.Completed)
+}
+
+func TestKeyLocks(t *testing.T) {
+	var locks keyLocks
+	unlock := locks.lock("upload-1")
+	// other uploads aren't blocked by upload-1
+	locks.lock("upload-2")()
+
+	locked := make(chan struct{})
+	go func() {
+		defer locks.lock("upload-1")()
+		close(locked)
+	}()
+	select {
+	case <-locked:
+		t.Fatal("upload-1 was locked twice")
+	case <-time.After(10 * time.Millisecond):
+	}
+	unlock()
+	<-locked
+	assert.Eventually(t, func() bool {
+		locks.mu.Lock()
+		defer locks.mu.Unlock()
+		return len(locks.locks) == 0
+	}, time.Second, time.Millisecond)
+}
+
+func TestUploadInParts(t *testing.T) {
+	mb := newTestClient(t, t.TempDir(), client.WithUploadChunkSize(1024), client.WithParallelTransfers(3, 4*1024))
+	ctx := context.Background()
+	data := bytes.Repeat([]byte("modelbox"), 18*128)
+	path := filepath.Join(t.TempDir(), "model.pt")
+	assert.Nil(t, os.WriteFile(path, data, 0644))
+
+	resp, err := mb.UploadFile(ctx, "weights", "exp-1", path, client.FileTypeModel)
+	assert.Nil(t, err)
+	artifacts, err := mb.ListArtifacts(ctx, "exp-1")
+	assert.Nil(t, err)
+	assert.Len(t, artifacts, 1)
+	assert.Equal(t, resp.ArtifactId, artifacts[0].Id)
+	assert.Equal(t, resp.Checksum, artifacts[0].Files[0].Checksum)
+
+	downloaded := filepath.Join(t.TempDir(), "downloaded.pt")
+	_, err = mb.DownloadBlob(ctx, resp.Id, downloaded)
+	assert.Nil(t, err)
+	b, err := os.ReadFile(downloaded)
+	assert.Nil(t, err)
+	assert.Equal(t, data, b)
+}
+
+func TestWatch(t *testing.T) {
+	mb := newTestClient(t, t.TempDir())
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	exp, err := mb.CreateExperiment(ctx, "bert", "owner@email", "langtech", "", "pytorch")
+	assert.Nil(t, err)
+
+	w := mb.Watch(ctx, "langtech", client.WatchSince(time.Unix(0, 0)),
+		client.WatchObjectKinds(client.ObjectKindExperiment, client.ObjectKindMetadata))
+	defer w.Close()
+	event := <-w.Events()
+	assert.Equal(t, client.ObjectKindExperiment, event.Kind)
+	assert.Equal(t, exp.Id, event.Object.(*client.Experiment).Id)
+
+	// models are filtered out
+	_, err = mb.CreateModel(ctx, "bert-base", "owner@email", "langtech", "nlp", "")
+	assert.Nil(t, err)
+	assert.Nil(t, mb.UpdateMetadata(ctx, exp.Id, map[string]interface{}{"lr": 0.1}))
+	event = <-w.Events()
+	assert.Equal(t, client.ObjectKindMetadata, event.Kind)
+	assert.Equal(t, client.ChangeEventObjectUpdated, event.Type)
+	assert.Equal(t, map[string]interface{}{"lr": 0.1}, event.Object.(*client.MetadataChange).Metadata)
+	assert.Equal(t, uint64(3), event.Position)
+}
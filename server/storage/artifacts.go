@@ -0,0 +1,186 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type fileRow struct {
+	Id                string `db:"id"`
+	ArtifactId        string `db:"artifact_id"`
+	ParentId          string `db:"parent_id"`
+	FileType          int32  `db:"file_type"`
+	Checksum          string `db:"checksum"`
+	ChecksumAlgorithm int32  `db:"checksum_algorithm"`
+	SrcPath           string `db:"src_path"`
+	UploadPath        string `db:"upload_path"`
+	Size              uint64 `db:"size"`
+	Seq               int64  `db:"seq"`
+	CreatedAt         int64  `db:"created_at"`
+	UpdatedAt         int64  `db:"updated_at"`
+}
+
+func (r *fileRow) proto() *proto.FileMetadata {
+	return &proto.FileMetadata{
+		Id:                r.Id,
+		ParentId:          r.ParentId,
+		FileType:          proto.FileType(r.FileType),
+		Checksum:          r.Checksum,
+		ChecksumAlgorithm: proto.ChecksumAlgorithm(r.ChecksumAlgorithm),
+		SrcPath:           r.SrcPath,
+		UploadPath:        r.UploadPath,
+		Size:              r.Size,
+		CreatedAt:         timestamppb.New(fromNanos(r.CreatedAt)),
+		UpdatedAt:         timestamppb.New(fromNanos(r.UpdatedAt)),
+	}
+}
+
+// AddFiles adds files to the artifact with the given id, name and object,
+// creating the artifact if needed. Files with the id of a file of the
+// artifact replace it.
+func (s *Store) AddFiles(ctx context.Context, id, name, objectId string, files []*proto.FileMetadata) (*proto.Artifact, error) {
+	var artifact *proto.Artifact
+	err := s.tx(ctx, func(tx *sqlx.Tx) error {
+		now := nanos(s.now())
+		event := proto.ChangeEvent_OBJECT_UPDATED
+		res, err := tx.ExecContext(ctx, `INSERT INTO artifacts (id, name, object_id, created_at)
+			VALUES (?, ?, ?, ?) ON CONFLICT (id) DO NOTHING`, id, name, objectId, now)
+		if err != nil {
+			return err
+		}
+		if n, _ := res.RowsAffected(); n == 1 {
+			event = proto.ChangeEvent_OBJECT_CREATED
+		}
+		for _, f := range files {
+			if _, err := tx.ExecContext(ctx, `INSERT INTO files
+				(id, artifact_id, parent_id, file_type, checksum, checksum_algorithm, src_path, upload_path, size, seq, created_at, updated_at)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, (SELECT COALESCE(MAX(seq), 0) + 1 FROM files), ?, ?)
+				ON CONFLICT (id) DO UPDATE SET file_type = excluded.file_type, checksum = excluded.checksum,
+				checksum_algorithm = excluded.checksum_algorithm, upload_path = excluded.upload_path,
+				size = excluded.size, updated_at = excluded.updated_at`,
+				f.Id, id, f.ParentId, int32(f.FileType), f.Checksum, int32(f.ChecksumAlgorithm), f.SrcPath,
+				f.UploadPath, f.Size, now, now); err != nil {
+				return err
+			}
+		}
+		artifact, err = getArtifact(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		c, err := objectChange(ctx, tx, objectId)
+		if err != nil || c == nil {
+			return err
+		}
+		return s.recordChange(ctx, tx, artifactChange(c, event, artifact))
+	})
+	return artifact, err
+}
+
+func getArtifact(ctx context.Context, q sqlx.QueryerContext, id string) (*proto.Artifact, error) {
+	var row struct {
+		Id       string `db:"id"`
+		Name     string `db:"name"`
+		ObjectId string `db:"object_id"`
+	}
+	if err := sqlx.GetContext(ctx, q, &row, `SELECT id, name, object_id FROM artifacts WHERE id = ?`, id); err != nil {
+		return nil, notFound(err)
+	}
+	var files []fileRow
+	if err := sqlx.SelectContext(ctx, q, &files, `SELECT * FROM files WHERE artifact_id = ? ORDER BY seq`, id); err != nil {
+		return nil, err
+	}
+	a := &proto.Artifact{Id: row.Id, Name: row.Name, ObjectId: row.ObjectId}
+	for i := range files {
+		a.Files = append(a.Files, files[i].proto())
+	}
+	return a, nil
+}
+
+// ListArtifacts returns a page of the artifacts of an object in the order
+// they were created, and the cursor of the next page if there is one.
+func (s *Store) ListArtifacts(ctx context.Context, objectId string, page Page) ([]*proto.Artifact, *Cursor, error) {
+	where, order, args := page.query("created_at")
+	var rows []struct {
+		Id        string `db:"id"`
+		CreatedAt int64  `db:"created_at"`
+	}
+	if err := s.db.SelectContext(ctx, &rows,
+		`SELECT id, created_at FROM artifacts WHERE object_id = ?`+where+order,
+		append([]interface{}{objectId}, args...)...); err != nil {
+		return nil, nil, err
+	}
+	var next *Cursor
+	if page.more(len(rows)) {
+		rows = rows[:page.Size]
+		last := rows[len(rows)-1]
+		next = &Cursor{Key: strconv.FormatInt(last.CreatedAt, 10), Id: last.Id}
+	}
+	artifacts := make([]*proto.Artifact, 0, len(rows))
+	for _, row := range rows {
+		a, err := getArtifact(ctx, s.db, row.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		artifacts = append(artifacts, a)
+	}
+	return artifacts, next, nil
+}
+
+// GetFile returns the metadata of a file.
+func (s *Store) GetFile(ctx context.Context, id string) (*proto.FileMetadata, error) {
+	var row fileRow
+	if err := s.db.GetContext(ctx, &row, `SELECT * FROM files WHERE id = ?`, id); err != nil {
+		return nil, notFound(err)
+	}
+	return row.proto(), nil
+}
+
+// Upload is a committed upload of a file or of a part of a multipart upload.
+// Parts don't have a file or an artifact.
+type Upload struct {
+	Key        string `db:"key"`
+	Size       uint64 `db:"size"`
+	Checksum   string `db:"checksum"`
+	FileId     string `db:"file_id"`
+	ArtifactId string `db:"artifact_id"`
+	// CommittedAt is in unix nanoseconds.
+	CommittedAt int64 `db:"committed_at"`
+}
+
+// CommittedUpload returns the upload with the given key if it was
+// committed, or ErrNotFound.
+func (s *Store) CommittedUpload(ctx context.Context, key string) (*Upload, error) {
+	var u Upload
+	if err := s.db.GetContext(ctx, &u, `SELECT * FROM uploads WHERE key = ?`, key); err != nil {
+		return nil, notFound(err)
+	}
+	return &u, nil
+}
+
+// CommitUpload records an upload as committed, committing an upload twice
+// keeps the first commit.
+func (s *Store) CommitUpload(ctx context.Context, u *Upload) error {
+	u.CommittedAt = nanos(s.now())
+	_, err := s.db.NamedExecContext(ctx, `INSERT INTO uploads (key, size, checksum, file_id, artifact_id, committed_at)
+		VALUES (:key, :size, :checksum, :file_id, :artifact_id, :committed_at) ON CONFLICT (key) DO NOTHING`, u)
+	return err
+}
+
+// DeleteUploads forgets uploads, such as the parts of an assembled file.
+func (s *Store) DeleteUploads(ctx context.Context, keys ...string) error {
+	return s.tx(ctx, func(tx *sqlx.Tx) error {
+		for _, key := range keys {
+			if _, err := tx.ExecContext(ctx, `DELETE FROM uploads WHERE key = ?`, key); err != nil &&
+				!errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,157 @@
+package storage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// BlobStore keeps the contents of files in a directory. Uploads are written
+// to a staging area until they are committed, at which point they are moved
+// to the path of their file.
+type BlobStore struct {
+	dir string
+}
+
+// NewBlobStore creates a blob store in dir, creating the directory if
+// needed.
+func NewBlobStore(dir string) (*BlobStore, error) {
+	for _, d := range []string{dir, filepath.Join(dir, "uploads")} {
+		if err := os.MkdirAll(d, 0755); err != nil {
+			return nil, fmt.Errorf("unable to create blob directory: %v", err)
+		}
+	}
+	return &BlobStore{dir: dir}, nil
+}
+
+// uploadPath returns the staging path of an upload. Keys are chosen by
+// clients and are hashed to be safe file names.
+func (b *BlobStore) uploadPath(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(b.dir, "uploads", hex.EncodeToString(sum[:16]))
+}
+
+// path returns the path of a blob, rejecting paths which escape the store.
+func (b *BlobStore) path(blobPath string) (string, error) {
+	clean := filepath.Clean(filepath.FromSlash(blobPath))
+	if filepath.IsAbs(clean) || clean == "." || clean == ".." || strings.HasPrefix(clean, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid blob path %q", blobPath)
+	}
+	return filepath.Join(b.dir, clean), nil
+}
+
+// UploadSize returns the number of bytes staged for an upload.
+func (b *BlobStore) UploadSize(key string) (uint64, error) {
+	info, err := os.Stat(b.uploadPath(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return uint64(info.Size()), nil
+}
+
+// Append writes data at offset of an upload, which must be the number of
+// bytes staged so far.
+func (b *BlobStore) Append(key string, offset uint64, data []byte) error {
+	f, err := os.OpenFile(b.uploadPath(key), os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if uint64(info.Size()) != offset {
+		return fmt.Errorf("upload is at offset %v, not %v", info.Size(), offset)
+	}
+	if _, err := f.WriteAt(data, int64(offset)); err != nil {
+		// drop a partial write so that the upload resumes from offset
+		f.Truncate(int64(offset))
+		return err
+	}
+	return nil
+}
+
+// OpenUpload opens the staged contents of an upload.
+func (b *BlobStore) OpenUpload(key string) (*os.File, error) {
+	return os.Open(b.uploadPath(key))
+}
+
+// Commit moves the staged upload with the given keys, concatenated in order,
+// to blobPath.
+func (b *BlobStore) Commit(blobPath string, keys ...string) error {
+	dst, err := b.path(blobPath)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	if len(keys) == 1 {
+		err := os.Rename(b.uploadPath(keys[0]), dst)
+		if !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		// nothing was staged for an empty upload, assemble an empty blob
+	}
+	tmp, err := os.CreateTemp(filepath.Join(b.dir, "uploads"), "assemble-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+	for _, key := range keys {
+		if err := b.copyUpload(tmp, key); err != nil {
+			return err
+		}
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), dst); err != nil {
+		return err
+	}
+	for _, key := range keys {
+		os.Remove(b.uploadPath(key))
+	}
+	return nil
+}
+
+func (b *BlobStore) copyUpload(w io.Writer, key string) error {
+	f, err := b.OpenUpload(key)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(w, f)
+	return err
+}
+
+// Discard drops the staged contents of an upload.
+func (b *BlobStore) Discard(key string) error {
+	err := os.Remove(b.uploadPath(key))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// Open opens a committed blob.
+func (b *BlobStore) Open(blobPath string) (*os.File, error) {
+	p, err := b.path(blobPath)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(p)
+}
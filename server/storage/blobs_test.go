@@ -0,0 +1,38 @@
+package storage
+
+import (
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlobStore(t *testing.T) {
+	b, err := NewBlobStore(t.TempDir())
+	assert.Nil(t, err)
+
+	assert.Nil(t, b.Append("upload-1/1", 0, []byte("model")))
+	assert.NotNil(t, b.Append("upload-1/1", 0, []byte("model")))
+	assert.Nil(t, b.Append("upload-1/1", 5, []byte("box")))
+	size, err := b.UploadSize("upload-1/1")
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(8), size)
+	assert.Nil(t, b.Append("upload-1/2", 0, []byte(" server")))
+
+	assert.Nil(t, b.Commit("modelbox/artifacts/exp-1/file-1", "upload-1/1", "upload-1/2", "upload-1/3"))
+	f, err := b.Open("modelbox/artifacts/exp-1/file-1")
+	assert.Nil(t, err)
+	defer f.Close()
+	data, err := io.ReadAll(f)
+	assert.Nil(t, err)
+	assert.Equal(t, "modelbox server", string(data))
+	size, err = b.UploadSize("upload-1/1")
+	assert.Nil(t, err)
+	assert.Zero(t, size)
+
+	// empty uploads have nothing staged
+	assert.Nil(t, b.Commit("modelbox/artifacts/exp-1/empty", "upload-2"))
+	assert.NotNil(t, b.Commit("../escaped", "upload-3"))
+	_, err = b.Open("/etc/passwd")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,172 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/tensorland/modelbox/internal/changelog"
+	"github.com/tensorland/modelbox/sdk-go/proto"
+)
+
+// Change is an entry of the change log of a namespace. The name, owner and
+// framework of changes to artifacts and metadata are the ones of the object
+// they belong to, except for the name of artifacts, so that watches can
+// filter them.
+type Change struct {
+	Position    uint64            `db:"position"`
+	Namespace   string            `db:"namespace"`
+	ObjectKind  proto.ObjectKind  `db:"object_kind"`
+	Event       proto.ChangeEvent `db:"event"`
+	ObjectId    string            `db:"object_id"`
+	Name        string            `db:"name"`
+	Owner       string            `db:"owner"`
+	MLFramework proto.MLFramework `db:"ml_framework"`
+	// Payload is the JSON encoding of the object after the change.
+	Payload   string `db:"payload"`
+	CreatedAt int64  `db:"created_at"`
+
+	payload interface{}
+}
+
+// Time returns the time at which the change happened.
+func (c *Change) Time() time.Time {
+	return fromNanos(c.CreatedAt)
+}
+
+// Entry returns the fields of the change which watches filter on.
+func (c *Change) Entry() *changelog.Entry {
+	return &changelog.Entry{
+		Time:      c.Time(),
+		Kind:      c.ObjectKind,
+		Event:     c.Event,
+		Name:      c.Name,
+		Owner:     c.Owner,
+		Framework: c.MLFramework,
+	}
+}
+
+func experimentChange(event proto.ChangeEvent, e *proto.Experiment) *Change {
+	return &Change{
+		Namespace:   e.Namespace,
+		ObjectKind:  proto.ObjectKind_OBJECT_KIND_EXPERIMENT,
+		Event:       event,
+		ObjectId:    e.Id,
+		Name:        e.Name,
+		Owner:       e.Owner,
+		MLFramework: e.Framework,
+		payload:     changelog.NewExperimentPayload(e),
+	}
+}
+
+func modelChange(event proto.ChangeEvent, m *proto.Model) *Change {
+	return &Change{
+		Namespace:  m.Namespace,
+		ObjectKind: proto.ObjectKind_OBJECT_KIND_MODEL,
+		Event:      event,
+		ObjectId:   m.Id,
+		Name:       m.Name,
+		Owner:      m.Owner,
+		payload:    changelog.NewModelPayload(m),
+	}
+}
+
+func modelVersionChange(event proto.ChangeEvent, v *proto.ModelVersion, namespace, owner string) *Change {
+	return &Change{
+		Namespace:   namespace,
+		ObjectKind:  proto.ObjectKind_OBJECT_KIND_MODEL_VERSION,
+		Event:       event,
+		ObjectId:    v.Id,
+		Name:        v.Name,
+		Owner:       owner,
+		MLFramework: v.Framework,
+		payload:     changelog.NewModelVersionPayload(v, namespace),
+	}
+}
+
+// artifactChange turns c, the change of the object of an artifact, into a
+// change of the artifact.
+func artifactChange(c *Change, event proto.ChangeEvent, a *proto.Artifact) *Change {
+	c.ObjectKind = proto.ObjectKind_OBJECT_KIND_ARTIFACT
+	c.Event = event
+	c.ObjectId = a.Id
+	c.Name = a.Name
+	c.payload = changelog.NewArtifactPayload(a)
+	return c
+}
+
+// metadataChange turns c, the change of an object, into a change of its
+// metadata.
+func metadataChange(c *Change, objectId string, meta map[string]string) *Change {
+	c.ObjectKind = proto.ObjectKind_OBJECT_KIND_METADATA
+	c.Event = proto.ChangeEvent_OBJECT_UPDATED
+	c.payload = changelog.NewMetadataPayload(objectId, meta)
+	return c
+}
+
+// objectChange returns a change carrying the namespace, name, owner and
+// framework of an experiment, model or model version, or nil if there is no
+// such object and its changes can't be watched.
+func objectChange(ctx context.Context, tx *sqlx.Tx, id string) (*Change, error) {
+	c := &Change{ObjectId: id}
+	var e experimentRow
+	err := tx.GetContext(ctx, &e, `SELECT * FROM experiments WHERE id = ?`, id)
+	if err == nil {
+		c.Namespace, c.Name, c.Owner, c.MLFramework = e.Namespace, e.Name, e.Owner, proto.MLFramework(e.MLFramework)
+		return c, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	var m modelRow
+	err = tx.GetContext(ctx, &m, `SELECT * FROM models WHERE id = ?`, id)
+	if err == nil {
+		c.Namespace, c.Name, c.Owner = m.Namespace, m.Name, m.Owner
+		return c, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	var v struct {
+		modelVersionRow
+		Owner string `db:"owner"`
+	}
+	err = tx.GetContext(ctx, &v, `SELECT v.*, m.owner FROM model_versions v
+		JOIN models m ON m.id = v.model_id WHERE v.id = ?`, id)
+	if err == nil {
+		c.Namespace, c.Name, c.Owner, c.MLFramework = v.Namespace, v.Name, v.Owner, proto.MLFramework(v.MLFramework)
+		return c, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// recordChange appends a change to the change log.
+func (s *Store) recordChange(ctx context.Context, tx *sqlx.Tx, c *Change) error {
+	payload, err := json.Marshal(c.payload)
+	if err != nil {
+		return err
+	}
+	c.Payload = string(payload)
+	c.CreatedAt = nanos(s.now())
+	_, err = tx.NamedExecContext(ctx, `INSERT INTO changes
+		(namespace, object_kind, event, object_id, name, owner, ml_framework, payload, created_at) VALUES
+		(:namespace, :object_kind, :event, :object_id, :name, :owner, :ml_framework, :payload, :created_at)`, c)
+	return err
+}
+
+// Changes returns up to limit changes of a namespace which happened after
+// the change at position, in order.
+func (s *Store) Changes(ctx context.Context, namespace string, position uint64, limit int) ([]*Change, error) {
+	var changes []*Change
+	if err := s.db.SelectContext(ctx, &changes, `SELECT * FROM changes
+		WHERE namespace = ? AND position > ? ORDER BY position LIMIT ?`, namespace, position, limit); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
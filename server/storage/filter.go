@@ -0,0 +1,83 @@
+package storage
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/tensorland/modelbox/sdk-go/proto"
+)
+
+// Filter selects the experiments or models of a namespace, zero fields match
+// every object. Framework and ExternalId only apply to experiments and Task
+// to models.
+type Filter struct {
+	Owner      string
+	Framework  proto.MLFramework
+	ExternalId string
+	Task       string
+	// The bounds of the creation and update times, After is inclusive and
+	// Before exclusive.
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	UpdatedAfter  time.Time
+	UpdatedBefore time.Time
+	// Metadata holds the encoded values of metadata keys which the objects
+	// hold.
+	Metadata map[string]string
+}
+
+// where returns the conditions of the filter and their arguments.
+func (f *Filter) where() (string, []interface{}) {
+	var where string
+	var args []interface{}
+	and := func(condition string, arg interface{}) {
+		where += " AND " + condition
+		args = append(args, arg)
+	}
+	if f.Owner != "" {
+		and("owner = ?", f.Owner)
+	}
+	if f.Framework != proto.MLFramework_UNKNOWN {
+		and("ml_framework = ?", int32(f.Framework))
+	}
+	if f.ExternalId != "" {
+		and("external_id = ?", f.ExternalId)
+	}
+	if f.Task != "" {
+		and("task = ?", f.Task)
+	}
+	if !f.CreatedAfter.IsZero() {
+		and("created_at >= ?", nanos(f.CreatedAfter))
+	}
+	if !f.CreatedBefore.IsZero() {
+		and("created_at < ?", nanos(f.CreatedBefore))
+	}
+	if !f.UpdatedAfter.IsZero() {
+		and("updated_at >= ?", nanos(f.UpdatedAfter))
+	}
+	if !f.UpdatedBefore.IsZero() {
+		and("updated_at < ?", nanos(f.UpdatedBefore))
+	}
+	keys := make([]string, 0, len(f.Metadata))
+	for k := range f.Metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		where += " AND id IN (SELECT parent_id FROM metadata WHERE name = ? AND value = ?)"
+		args = append(args, k, f.Metadata[k])
+	}
+	return where, args
+}
+
+// sortKey returns the key of an object in a listing sorted by field.
+func sortKey(field proto.SortField, name string, createdAt, updatedAt int64) string {
+	switch field {
+	case proto.SortField_SORT_FIELD_UPDATED_AT:
+		return strconv.FormatInt(updatedAt, 10)
+	case proto.SortField_SORT_FIELD_NAME:
+		return name
+	}
+	return strconv.FormatInt(createdAt, 10)
+}
@@ -0,0 +1,493 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type experimentRow struct {
+	Id          string `db:"id"`
+	Name        string `db:"name"`
+	Namespace   string `db:"namespace"`
+	Owner       string `db:"owner"`
+	MLFramework int32  `db:"ml_framework"`
+	ExternalId  string `db:"external_id"`
+	CreatedAt   int64  `db:"created_at"`
+	UpdatedAt   int64  `db:"updated_at"`
+}
+
+func (r *experimentRow) proto() *proto.Experiment {
+	return &proto.Experiment{
+		Id:         r.Id,
+		Name:       r.Name,
+		Namespace:  r.Namespace,
+		Owner:      r.Owner,
+		Framework:  proto.MLFramework(r.MLFramework),
+		ExternalId: r.ExternalId,
+		CreatedAt:  timestamppb.New(fromNanos(r.CreatedAt)),
+		UpdatedAt:  timestamppb.New(fromNanos(r.UpdatedAt)),
+	}
+}
+
+// CreateExperiment stores an experiment unless one with the same id exists,
+// in which case the existing experiment is returned along with true.
+func (s *Store) CreateExperiment(ctx context.Context, e *proto.Experiment) (*proto.Experiment, bool, error) {
+	var created *proto.Experiment
+	exists := false
+	err := s.tx(ctx, func(tx *sqlx.Tx) error {
+		var row experimentRow
+		err := tx.GetContext(ctx, &row, `SELECT * FROM experiments WHERE id = ?`, e.Id)
+		if err == nil {
+			created, exists = row.proto(), true
+			return nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		now := nanos(s.now())
+		row = experimentRow{
+			Id:          e.Id,
+			Name:        e.Name,
+			Namespace:   e.Namespace,
+			Owner:       e.Owner,
+			MLFramework: int32(e.Framework),
+			ExternalId:  e.ExternalId,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		if _, err := tx.NamedExecContext(ctx, `INSERT INTO experiments
+			(id, name, namespace, owner, ml_framework, external_id, created_at, updated_at) VALUES
+			(:id, :name, :namespace, :owner, :ml_framework, :external_id, :created_at, :updated_at)`, &row); err != nil {
+			return err
+		}
+		created = row.proto()
+		return s.recordChange(ctx, tx, experimentChange(proto.ChangeEvent_OBJECT_CREATED, created))
+	})
+	return created, exists, err
+}
+
+func (s *Store) GetExperiment(ctx context.Context, id string) (*proto.Experiment, error) {
+	var row experimentRow
+	if err := s.db.GetContext(ctx, &row, `SELECT * FROM experiments WHERE id = ?`, id); err != nil {
+		return nil, notFound(err)
+	}
+	return row.proto(), nil
+}
+
+// ListExperiments returns a page of the experiments of a namespace which match filter,
+// in the order of the page, and the cursor of the next page if there is one.
+func (s *Store) ListExperiments(ctx context.Context, namespace string, filter *Filter, page Page) ([]*proto.Experiment, *Cursor, error) {
+	conditions, args := filter.where()
+	where, order, pageArgs := page.query(sortColumn(page.OrderBy))
+	args = append(append([]interface{}{namespace}, args...), pageArgs...)
+	var rows []experimentRow
+	if err := s.db.SelectContext(ctx, &rows,
+		`SELECT * FROM experiments WHERE namespace = ?`+conditions+where+order, args...); err != nil {
+		return nil, nil, err
+	}
+	var next *Cursor
+	if page.more(len(rows)) {
+		rows = rows[:page.Size]
+		last := rows[len(rows)-1]
+		next = &Cursor{Key: sortKey(page.OrderBy, last.Name, last.CreatedAt, last.UpdatedAt), Id: last.Id}
+	}
+	experiments := make([]*proto.Experiment, 0, len(rows))
+	for i := range rows {
+		experiments = append(experiments, rows[i].proto())
+	}
+	return experiments, next, nil
+}
+
+type modelRow struct {
+	Id          string `db:"id"`
+	Name        string `db:"name"`
+	Owner       string `db:"owner"`
+	Namespace   string `db:"namespace"`
+	Task        string `db:"task"`
+	Description string `db:"description"`
+	CreatedAt   int64  `db:"created_at"`
+	UpdatedAt   int64  `db:"updated_at"`
+}
+
+func (r *modelRow) proto() *proto.Model {
+	return &proto.Model{
+		Id:          r.Id,
+		Name:        r.Name,
+		Owner:       r.Owner,
+		Namespace:   r.Namespace,
+		Task:        r.Task,
+		Description: r.Description,
+		CreatedAt:   timestamppb.New(fromNanos(r.CreatedAt)),
+		UpdatedAt:   timestamppb.New(fromNanos(r.UpdatedAt)),
+	}
+}
+
+// CreateModel stores a model unless one with the same id exists, in which
+// case the existing model is returned along with true.
+func (s *Store) CreateModel(ctx context.Context, m *proto.Model) (*proto.Model, bool, error) {
+	var created *proto.Model
+	exists := false
+	err := s.tx(ctx, func(tx *sqlx.Tx) error {
+		var row modelRow
+		err := tx.GetContext(ctx, &row, `SELECT * FROM models WHERE id = ?`, m.Id)
+		if err == nil {
+			created, exists = row.proto(), true
+			return nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		now := nanos(s.now())
+		row = modelRow{
+			Id:          m.Id,
+			Name:        m.Name,
+			Owner:       m.Owner,
+			Namespace:   m.Namespace,
+			Task:        m.Task,
+			Description: m.Description,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		if _, err := tx.NamedExecContext(ctx, `INSERT INTO models
+			(id, name, owner, namespace, task, description, created_at, updated_at) VALUES
+			(:id, :name, :owner, :namespace, :task, :description, :created_at, :updated_at)`, &row); err != nil {
+			return err
+		}
+		created = row.proto()
+		return s.recordChange(ctx, tx, modelChange(proto.ChangeEvent_OBJECT_CREATED, created))
+	})
+	return created, exists, err
+}
+
+func (s *Store) GetModel(ctx context.Context, id string) (*proto.Model, error) {
+	var row modelRow
+	if err := s.db.GetContext(ctx, &row, `SELECT * FROM models WHERE id = ?`, id); err != nil {
+		return nil, notFound(err)
+	}
+	return row.proto(), nil
+}
+
+// ListModels returns a page of the models of a namespace which match filter,
+// in the order of the page, and the cursor of the next page if there is one.
+func (s *Store) ListModels(ctx context.Context, namespace string, filter *Filter, page Page) ([]*proto.Model, *Cursor, error) {
+	conditions, args := filter.where()
+	where, order, pageArgs := page.query(sortColumn(page.OrderBy))
+	args = append(append([]interface{}{namespace}, args...), pageArgs...)
+	var rows []modelRow
+	if err := s.db.SelectContext(ctx, &rows,
+		`SELECT * FROM models WHERE namespace = ?`+conditions+where+order, args...); err != nil {
+		return nil, nil, err
+	}
+	var next *Cursor
+	if page.more(len(rows)) {
+		rows = rows[:page.Size]
+		last := rows[len(rows)-1]
+		next = &Cursor{Key: sortKey(page.OrderBy, last.Name, last.CreatedAt, last.UpdatedAt), Id: last.Id}
+	}
+	models := make([]*proto.Model, 0, len(rows))
+	for i := range rows {
+		models = append(models, rows[i].proto())
+	}
+	return models, next, nil
+}
+
+type modelVersionRow struct {
+	Id          string `db:"id"`
+	ModelId     string `db:"model_id"`
+	Name        string `db:"name"`
+	Version     string `db:"version"`
+	Description string `db:"description"`
+	Namespace   string `db:"namespace"`
+	MLFramework int32  `db:"ml_framework"`
+	UniqueTags  string `db:"unique_tags"`
+	CreatedAt   int64  `db:"created_at"`
+	UpdatedAt   int64  `db:"updated_at"`
+}
+
+func (r *modelVersionRow) proto() *proto.ModelVersion {
+	var tags []string
+	// tags are written by the store, a corrupt value is read as no tags
+	json.Unmarshal([]byte(r.UniqueTags), &tags)
+	return &proto.ModelVersion{
+		Id:          r.Id,
+		ModelId:     r.ModelId,
+		Name:        r.Name,
+		Version:     r.Version,
+		Description: r.Description,
+		Framework:   proto.MLFramework(r.MLFramework),
+		UniqueTags:  tags,
+		CreatedAt:   timestamppb.New(fromNanos(r.CreatedAt)),
+		UpdatedAt:   timestamppb.New(fromNanos(r.UpdatedAt)),
+	}
+}
+
+// CreateModelVersion stores a version of a model unless one with the same id
+// exists, in which case the existing version is returned along with true.
+// The model must exist.
+func (s *Store) CreateModelVersion(ctx context.Context, v *proto.ModelVersion, namespace string) (*proto.ModelVersion, bool, error) {
+	var created *proto.ModelVersion
+	exists := false
+	err := s.tx(ctx, func(tx *sqlx.Tx) error {
+		var model modelRow
+		if err := tx.GetContext(ctx, &model, `SELECT * FROM models WHERE id = ?`, v.ModelId); err != nil {
+			return notFound(err)
+		}
+		var row modelVersionRow
+		err := tx.GetContext(ctx, &row, `SELECT * FROM model_versions WHERE id = ?`, v.Id)
+		if err == nil {
+			created, exists = row.proto(), true
+			return nil
+		}
+		if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		if namespace == "" {
+			namespace = model.Namespace
+		}
+		tags, err := json.Marshal(v.UniqueTags)
+		if err != nil {
+			return err
+		}
+		now := nanos(s.now())
+		row = modelVersionRow{
+			Id:          v.Id,
+			ModelId:     v.ModelId,
+			Name:        v.Name,
+			Version:     v.Version,
+			Description: v.Description,
+			Namespace:   namespace,
+			MLFramework: int32(v.Framework),
+			UniqueTags:  string(tags),
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		if _, err := tx.NamedExecContext(ctx, `INSERT INTO model_versions
+			(id, model_id, name, version, description, namespace, ml_framework, unique_tags, created_at, updated_at) VALUES
+			(:id, :model_id, :name, :version, :description, :namespace, :ml_framework, :unique_tags, :created_at, :updated_at)`,
+			&row); err != nil {
+			return err
+		}
+		created = row.proto()
+		return s.recordChange(ctx, tx, modelVersionChange(proto.ChangeEvent_OBJECT_CREATED, created, namespace, model.Owner))
+	})
+	return created, exists, err
+}
+
+// ListModelVersions returns a page of the versions of a model in the order
+// they were created, and the cursor of the next page if there is one.
+func (s *Store) ListModelVersions(ctx context.Context, modelId string, page Page) ([]*proto.ModelVersion, *Cursor, error) {
+	where, order, args := page.query("created_at")
+	var rows []modelVersionRow
+	if err := s.db.SelectContext(ctx, &rows,
+		`SELECT * FROM model_versions WHERE model_id = ?`+where+order,
+		append([]interface{}{modelId}, args...)...); err != nil {
+		return nil, nil, err
+	}
+	var next *Cursor
+	if page.more(len(rows)) {
+		rows = rows[:page.Size]
+		last := rows[len(rows)-1]
+		next = &Cursor{Key: strconv.FormatInt(last.CreatedAt, 10), Id: last.Id}
+	}
+	versions := make([]*proto.ModelVersion, 0, len(rows))
+	for i := range rows {
+		versions = append(versions, rows[i].proto())
+	}
+	return versions, next, nil
+}
+
+// UpdateMetadata merges metadata into the metadata of an object,
+// overwriting the values of existing keys.
+func (s *Store) UpdateMetadata(ctx context.Context, parentId string, metadata map[string]string) error {
+	return s.tx(ctx, func(tx *sqlx.Tx) error {
+		now := nanos(s.now())
+		for k, v := range metadata {
+			if _, err := tx.ExecContext(ctx, `INSERT INTO metadata (parent_id, name, value, created_at, updated_at)
+				VALUES (?, ?, ?, ?, ?)
+				ON CONFLICT (parent_id, name) DO UPDATE SET value = excluded.value, updated_at = excluded.updated_at`,
+				parentId, k, v, now, now); err != nil {
+				return err
+			}
+		}
+		meta, err := listMetadata(ctx, tx, parentId)
+		if err != nil {
+			return err
+		}
+		c, err := objectChange(ctx, tx, parentId)
+		if err != nil || c == nil {
+			return err
+		}
+		return s.recordChange(ctx, tx, metadataChange(c, parentId, meta))
+	})
+}
+
+func (s *Store) ListMetadata(ctx context.Context, parentId string) (map[string]string, error) {
+	return listMetadata(ctx, s.db, parentId)
+}
+
+func listMetadata(ctx context.Context, q sqlx.QueryerContext, parentId string) (map[string]string, error) {
+	var rows []struct {
+		Name  string `db:"name"`
+		Value string `db:"value"`
+	}
+	if err := sqlx.SelectContext(ctx, q, &rows, `SELECT name, value FROM metadata WHERE parent_id = ?`, parentId); err != nil {
+		return nil, err
+	}
+	meta := make(map[string]string, len(rows))
+	for _, row := range rows {
+		meta[row.Name] = row.Value
+	}
+	return meta, nil
+}
+
+type metricRow struct {
+	Key           string          `db:"key"`
+	Step          uint64          `db:"step"`
+	WallclockTime uint64          `db:"wallclock_time"`
+	FVal          sql.NullFloat64 `db:"f_val"`
+	STensor       sql.NullString  `db:"s_tensor"`
+	BTensor       []byte          `db:"b_tensor"`
+}
+
+// firstAttempt records the idempotency key of a call logging a kind of
+// value to an object and reports whether the key is new, calls without a key
+// always are.
+func (s *Store) firstAttempt(ctx context.Context, tx *sqlx.Tx, kind, parentId, key string) (bool, error) {
+	if key == "" {
+		return true, nil
+	}
+	res, err := tx.ExecContext(ctx, `INSERT OR IGNORE INTO idempotency_keys (kind, parent_id, key, created_at)
+		VALUES (?, ?, ?, ?)`, kind, parentId, key, nanos(s.now()))
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// LogMetric appends a value to the metric key of an object. Values logged
+// again with the same idempotency key are only recorded once.
+func (s *Store) LogMetric(ctx context.Context, parentId, key string, v *proto.MetricsValue, idempotencyKey string) error {
+	row := metricRow{Key: key, Step: v.Step, WallclockTime: v.WallclockTime}
+	switch value := v.Value.(type) {
+	case *proto.MetricsValue_FVal:
+		row.FVal = sql.NullFloat64{Float64: float64(value.FVal), Valid: true}
+	case *proto.MetricsValue_STensor:
+		row.STensor = sql.NullString{String: value.STensor, Valid: true}
+	case *proto.MetricsValue_BTensor:
+		row.BTensor = value.BTensor
+	}
+	return s.tx(ctx, func(tx *sqlx.Tx) error {
+		if first, err := s.firstAttempt(ctx, tx, "metrics", parentId, idempotencyKey); err != nil || !first {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `INSERT INTO metrics (parent_id, key, step, wallclock_time, f_val, s_tensor, b_tensor)
+			VALUES (?, ?, ?, ?, ?, ?, ?)`, parentId, row.Key, row.Step, row.WallclockTime, row.FVal, row.STensor, row.BTensor)
+		return err
+	})
+}
+
+// GetMetrics returns the metrics of an object, with their values in the
+// order they were logged.
+func (s *Store) GetMetrics(ctx context.Context, parentId string) (map[string]*proto.Metrics, error) {
+	var rows []metricRow
+	if err := s.db.SelectContext(ctx, &rows, `SELECT key, step, wallclock_time, f_val, s_tensor, b_tensor
+		FROM metrics WHERE parent_id = ? ORDER BY id`, parentId); err != nil {
+		return nil, err
+	}
+	metrics := map[string]*proto.Metrics{}
+	for _, row := range rows {
+		m, ok := metrics[row.Key]
+		if !ok {
+			m = &proto.Metrics{Key: row.Key}
+			metrics[row.Key] = m
+		}
+		v := &proto.MetricsValue{Step: row.Step, WallclockTime: row.WallclockTime}
+		switch {
+		case row.FVal.Valid:
+			v.Value = &proto.MetricsValue_FVal{FVal: float32(row.FVal.Float64)}
+		case row.STensor.Valid:
+			v.Value = &proto.MetricsValue_STensor{STensor: row.STensor.String}
+		case row.BTensor != nil:
+			v.Value = &proto.MetricsValue_BTensor{BTensor: row.BTensor}
+		}
+		m.Values = append(m.Values, v)
+	}
+	return metrics, nil
+}
+
+type eventRow struct {
+	Id            int64  `db:"id"`
+	Name          string `db:"name"`
+	Source        string `db:"source"`
+	WallclockTime int64  `db:"wallclock_time"`
+	Metadata      string `db:"metadata"`
+}
+
+// LogEvent records an event of an object, events without a time happened
+// when they are logged. Events logged again with the same idempotency key
+// are only recorded once.
+func (s *Store) LogEvent(ctx context.Context, parentId string, e *proto.Event, idempotencyKey string) (time.Time, error) {
+	now := s.now()
+	at := now
+	if e.WallclockTime != nil {
+		at = e.WallclockTime.AsTime()
+	}
+	meta, err := json.Marshal(e.GetMetadata().GetMetadata())
+	if err != nil {
+		return time.Time{}, err
+	}
+	err = s.tx(ctx, func(tx *sqlx.Tx) error {
+		if first, err := s.firstAttempt(ctx, tx, "events", parentId, idempotencyKey); err != nil || !first {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `INSERT INTO events (parent_id, name, source, wallclock_time, metadata)
+			VALUES (?, ?, ?, ?, ?)`, parentId, e.Name, e.GetSource().GetName(), nanos(at), string(meta))
+		return err
+	})
+	return now, err
+}
+
+// ListEvents returns a page of the events of an object which happened at or
+// after since, in the order they happened, and the cursor of the next page if
+// there is one.
+func (s *Store) ListEvents(ctx context.Context, parentId string, since time.Time, page Page) ([]*proto.Event, *Cursor, error) {
+	from := int64(math.MinInt64)
+	if !since.IsZero() {
+		from = nanos(since)
+	}
+	where, order, args := page.query("wallclock_time")
+	var rows []eventRow
+	if err := s.db.SelectContext(ctx, &rows, `SELECT id, name, source, wallclock_time, metadata
+		FROM events WHERE parent_id = ? AND wallclock_time >= ?`+where+order,
+		append([]interface{}{parentId, from}, args...)...); err != nil {
+		return nil, nil, err
+	}
+	var next *Cursor
+	if page.more(len(rows)) {
+		rows = rows[:page.Size]
+		last := rows[len(rows)-1]
+		next = &Cursor{Key: strconv.FormatInt(last.WallclockTime, 10), Id: strconv.FormatInt(last.Id, 10)}
+	}
+	events := make([]*proto.Event, 0, len(rows))
+	for _, row := range rows {
+		var meta map[string]string
+		json.Unmarshal([]byte(row.Metadata), &meta)
+		events = append(events, &proto.Event{
+			Name:          row.Name,
+			Source:        &proto.EventSource{Name: row.Source},
+			WallclockTime: timestamppb.New(fromNanos(row.WallclockTime)),
+			Metadata:      &proto.Metadata{Metadata: meta},
+		})
+	}
+	return events, next, nil
+}
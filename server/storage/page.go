@@ -0,0 +1,69 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/tensorland/modelbox/sdk-go/proto"
+)
+
+// Page selects part of a listing. Listings are ordered by a column and then
+// by id, a page holds the objects following After.
+type Page struct {
+	// Size is the maximum number of objects of the page, 0 selects all of
+	// them.
+	Size int
+	// After is the position of the last object of the previous page, nil
+	// for the first page.
+	After *Cursor
+	// OrderBy sorts listings of experiments and models, other listings are
+	// ordered by time.
+	OrderBy proto.SortField
+	// Descending reverses the order of the listing.
+	Descending bool
+}
+
+// sortColumn returns the column of the field listings are sorted by.
+func sortColumn(field proto.SortField) string {
+	switch field {
+	case proto.SortField_SORT_FIELD_UPDATED_AT:
+		return "updated_at"
+	case proto.SortField_SORT_FIELD_NAME:
+		return "name"
+	}
+	return "created_at"
+}
+
+// Cursor is the position of an object in a listing.
+type Cursor struct {
+	// Key is the value of the column the listing is ordered by, integers
+	// are compared as such by SQLite.
+	Key string
+	Id  string
+}
+
+// query returns the condition and the ordering selecting the objects of the
+// page from a listing ordered by column and id, and the arguments of the
+// condition. One object more than the page holds is selected, telling
+// whether another page follows.
+func (p Page) query(column string) (string, string, []interface{}) {
+	op, direction := ">", ""
+	if p.Descending {
+		op, direction = "<", " DESC"
+	}
+	var where string
+	var args []interface{}
+	if p.After != nil {
+		where = fmt.Sprintf(" AND (%[1]v %[2]v ? OR (%[1]v = ? AND id %[2]v ?))", column, op)
+		args = []interface{}{p.After.Key, p.After.Key, p.After.Id}
+	}
+	order := fmt.Sprintf(" ORDER BY %[1]v%[2]v, id%[2]v", column, direction)
+	if p.Size > 0 {
+		order += fmt.Sprintf(" LIMIT %d", p.Size+1)
+	}
+	return where, order, args
+}
+
+// more reports whether n selected objects overflow the page.
+func (p Page) more(n int) bool {
+	return p.Size > 0 && n > p.Size
+}
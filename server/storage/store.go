@@ -0,0 +1,199 @@
+// Package storage persists the objects of the embedded ModelBox server. The
+// metadata of objects is kept in SQLite and the contents of files in a
+// directory of the local filesystem.
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// ErrNotFound is returned when an object doesn't exist.
+var ErrNotFound = errors.New("not found")
+
+// schema creates the tables of the store. Timestamps are stored as unix
+// nanoseconds and lists and maps as JSON.
+var schema = []string{
+	`CREATE TABLE IF NOT EXISTS experiments (
+		id TEXT PRIMARY KEY,
+		name TEXT NOT NULL,
+		namespace TEXT NOT NULL,
+		owner TEXT NOT NULL,
+		ml_framework INTEGER NOT NULL,
+		external_id TEXT NOT NULL,
+		created_at INTEGER NOT NULL,
+		updated_at INTEGER NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS experiments_namespace ON experiments (namespace)`,
+	`CREATE TABLE IF NOT EXISTS models (
+		id TEXT PRIMARY KEY,
+		name TEXT NOT NULL,
+		owner TEXT NOT NULL,
+		namespace TEXT NOT NULL,
+		task TEXT NOT NULL,
+		description TEXT NOT NULL,
+		created_at INTEGER NOT NULL,
+		updated_at INTEGER NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS models_namespace ON models (namespace)`,
+	`CREATE TABLE IF NOT EXISTS model_versions (
+		id TEXT PRIMARY KEY,
+		model_id TEXT NOT NULL REFERENCES models (id),
+		name TEXT NOT NULL,
+		version TEXT NOT NULL,
+		description TEXT NOT NULL,
+		namespace TEXT NOT NULL,
+		ml_framework INTEGER NOT NULL,
+		unique_tags TEXT NOT NULL,
+		created_at INTEGER NOT NULL,
+		updated_at INTEGER NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS model_versions_model_id ON model_versions (model_id)`,
+	`CREATE TABLE IF NOT EXISTS metadata (
+		parent_id TEXT NOT NULL,
+		name TEXT NOT NULL,
+		value TEXT NOT NULL,
+		created_at INTEGER NOT NULL,
+		updated_at INTEGER NOT NULL,
+		PRIMARY KEY (parent_id, name)
+	)`,
+	`CREATE TABLE IF NOT EXISTS metrics (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		parent_id TEXT NOT NULL,
+		key TEXT NOT NULL,
+		step INTEGER NOT NULL,
+		wallclock_time INTEGER NOT NULL,
+		f_val REAL,
+		s_tensor TEXT,
+		b_tensor BLOB
+	)`,
+	`CREATE INDEX IF NOT EXISTS metrics_parent_id ON metrics (parent_id)`,
+	`CREATE TABLE IF NOT EXISTS events (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		parent_id TEXT NOT NULL,
+		name TEXT NOT NULL,
+		source TEXT NOT NULL,
+		wallclock_time INTEGER NOT NULL,
+		metadata TEXT NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS events_parent_id ON events (parent_id, wallclock_time)`,
+	`CREATE TABLE IF NOT EXISTS idempotency_keys (
+		kind TEXT NOT NULL,
+		parent_id TEXT NOT NULL,
+		key TEXT NOT NULL,
+		created_at INTEGER NOT NULL,
+		PRIMARY KEY (kind, parent_id, key)
+	)`,
+	`CREATE TABLE IF NOT EXISTS artifacts (
+		id TEXT PRIMARY KEY,
+		name TEXT NOT NULL,
+		object_id TEXT NOT NULL,
+		created_at INTEGER NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS artifacts_object_id ON artifacts (object_id)`,
+	`CREATE TABLE IF NOT EXISTS files (
+		id TEXT PRIMARY KEY,
+		artifact_id TEXT NOT NULL REFERENCES artifacts (id),
+		parent_id TEXT NOT NULL,
+		file_type INTEGER NOT NULL,
+		checksum TEXT NOT NULL,
+		checksum_algorithm INTEGER NOT NULL,
+		src_path TEXT NOT NULL,
+		upload_path TEXT NOT NULL,
+		size INTEGER NOT NULL,
+		seq INTEGER NOT NULL,
+		created_at INTEGER NOT NULL,
+		updated_at INTEGER NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS files_artifact_id ON files (artifact_id)`,
+	`CREATE TABLE IF NOT EXISTS uploads (
+		key TEXT PRIMARY KEY,
+		size INTEGER NOT NULL,
+		checksum TEXT NOT NULL,
+		file_id TEXT NOT NULL,
+		artifact_id TEXT NOT NULL,
+		committed_at INTEGER NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS changes (
+		position INTEGER PRIMARY KEY AUTOINCREMENT,
+		namespace TEXT NOT NULL,
+		object_kind INTEGER NOT NULL,
+		event INTEGER NOT NULL,
+		object_id TEXT NOT NULL,
+		name TEXT NOT NULL,
+		owner TEXT NOT NULL,
+		ml_framework INTEGER NOT NULL,
+		payload TEXT NOT NULL,
+		created_at INTEGER NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS changes_namespace ON changes (namespace, position)`,
+}
+
+// Store keeps the metadata of objects in a SQLite database.
+type Store struct {
+	db  *sqlx.DB
+	now func() time.Time
+}
+
+// Open opens the SQLite database at path, creating it and its tables if
+// needed. ":memory:" opens a database which lives as long as the store.
+func Open(path string) (*Store, error) {
+	dsn := fmt.Sprintf("file:%v?_foreign_keys=on&_busy_timeout=5000&_journal_mode=WAL", path)
+	db, err := sqlx.Open("sqlite3", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open database %v: %v", path, err)
+	}
+	// SQLite has a single writer, sharing one connection avoids busy errors
+	// and keeps in-memory databases alive
+	db.SetMaxOpenConns(1)
+	for _, stmt := range schema {
+		if _, err := db.Exec(stmt); err != nil {
+			db.Close()
+			return nil, fmt.Errorf("unable to create schema: %v", err)
+		}
+	}
+	return &Store{db: db, now: time.Now}, nil
+}
+
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Ping checks that the database can be queried.
+func (s *Store) Ping(ctx context.Context) error {
+	return s.db.PingContext(ctx)
+}
+
+// tx runs fn in a transaction, which is committed when fn returns nil.
+func (s *Store) tx(ctx context.Context, fn func(tx *sqlx.Tx) error) error {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func nanos(t time.Time) int64 {
+	return t.UnixNano()
+}
+
+func fromNanos(n int64) time.Time {
+	return time.Unix(0, n).UTC()
+}
+
+func notFound(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrNotFound
+	}
+	return err
+}
@@ -0,0 +1,415 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	client "github.com/tensorland/modelbox/sdk-go"
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"github.com/tensorland/modelbox/server/storage"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var crc32c = crc32.MakeTable(crc32.Castagnoli)
+
+// keyLocks holds a mutex for every key in use, so that uploads are only
+// serialized with the frames of the same upload.
+type keyLocks struct {
+	mu    sync.Mutex
+	locks map[string]*keyLock
+}
+
+type keyLock struct {
+	sync.Mutex
+	// refs counts the holders and waiters of the lock, it is dropped at 0
+	refs int
+}
+
+// lock locks key and returns the function unlocking it.
+func (l *keyLocks) lock(key string) func() {
+	l.mu.Lock()
+	if l.locks == nil {
+		l.locks = map[string]*keyLock{}
+	}
+	kl, ok := l.locks[key]
+	if !ok {
+		kl = &keyLock{}
+		l.locks[key] = kl
+	}
+	kl.refs++
+	l.mu.Unlock()
+
+	kl.Lock()
+	return func() {
+		kl.Unlock()
+		l.mu.Lock()
+		if kl.refs--; kl.refs == 0 {
+			delete(l.locks, key)
+		}
+		l.mu.Unlock()
+	}
+}
+
+func uploadKey(meta *proto.UploadFileMetadata) string {
+	if meta.PartNumber == 0 {
+		return meta.UploadId
+	}
+	return fmt.Sprintf("%v/%v", meta.UploadId, meta.PartNumber)
+}
+
+// legacyUploadKey returns a key staging an upload sent as plain chunks,
+// which can't be resumed.
+func legacyUploadKey() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "legacy/" + hex.EncodeToString(b), nil
+}
+
+// validObjectId reports whether an object id can be used in the upload path
+// of its files.
+func validObjectId(id string) bool {
+	return id != "" && id != "." && id != ".." && !strings.ContainsAny(id, `/\`)
+}
+
+func validateUpload(meta *proto.UploadFileMetadata) error {
+	return validate("uploads need an artifact name and a valid object id",
+		field{"artifact_name", meta.ArtifactName != ""}, field{"object_id", validObjectId(meta.ObjectId)})
+}
+
+func committedResponse(meta *proto.UploadFileMetadata, u *storage.Upload) *proto.UploadFileResponse {
+	return &proto.UploadFileResponse{
+		FileId:          u.FileId,
+		ArtifactId:      u.ArtifactId,
+		UploadId:        meta.UploadId,
+		CommittedOffset: u.Size,
+		Completed:       true,
+	}
+}
+
+// committed returns the response of an upload if it was committed, or nil.
+func (s *Server) committed(ctx context.Context, meta *proto.UploadFileMetadata, key string) (*proto.UploadFileResponse, error) {
+	u, err := s.store.CommittedUpload(ctx, key)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return committedResponse(meta, u), nil
+}
+
+// UploadFile stages the chunks of an upload on disk as they are received, so
+// that a broken stream can be resumed from the last chunk. A stream with only
+// the metadata frame returns the offset staged so far. Uploads sent as plain
+// chunks, without an upload id, are stored when the stream closes.
+func (s *Server) UploadFile(stream proto.ModelStore_UploadFileServer) error {
+	ctx := stream.Context()
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	meta := req.GetMetadata()
+	if meta.GetMetadata() == nil {
+		return status.Error(codes.InvalidArgument, "the first frame must be the file metadata")
+	}
+	if err := validateUpload(meta); err != nil {
+		return err
+	}
+	key := uploadKey(meta)
+	legacy := false
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if _, ok := req.StreamFrame.(*proto.UploadFileRequest_Chunks); ok && !legacy {
+			if meta.UploadId != "" {
+				return status.Error(codes.InvalidArgument, "resumable uploads must be sent in chunks with offsets")
+			}
+			legacy = true
+			if key, err = legacyUploadKey(); err != nil {
+				return toStatus(err)
+			}
+			// legacy uploads are either stored or dropped with their stream
+			defer s.blobs.Discard(key)
+		}
+		resp, err := s.receive(ctx, meta, key, req)
+		if err != nil {
+			return toStatus(err)
+		}
+		if resp != nil {
+			return stream.SendAndClose(resp)
+		}
+	}
+	defer s.uploadLocks.lock(key)()
+	if legacy {
+		size, sum, err := s.stagedChecksum(meta.Metadata.ChecksumAlgorithm, key)
+		if err != nil {
+			return toStatus(err)
+		}
+		if meta.Metadata.Checksum != "" && meta.Metadata.Checksum != sum {
+			return status.Errorf(codes.DataLoss, "checksum mismatch, received %v, computed %v",
+				meta.Metadata.Checksum, sum)
+		}
+		resp, err := s.storeFile(ctx, meta, size, sum, key)
+		if err != nil {
+			return toStatus(err)
+		}
+		return stream.SendAndClose(resp)
+	}
+	if meta.UploadId == "" {
+		return status.Error(codes.InvalidArgument, "uploads need an upload id or chunks")
+	}
+	resp, err := s.committed(ctx, meta, key)
+	if err != nil {
+		return toStatus(err)
+	}
+	if resp == nil {
+		size, err := s.blobs.UploadSize(key)
+		if err != nil {
+			return toStatus(err)
+		}
+		resp = &proto.UploadFileResponse{UploadId: meta.UploadId, CommittedOffset: size}
+	}
+	return stream.SendAndClose(resp)
+}
+
+// receive handles a frame of an upload, returning the response once it is
+// committed. The frames of an upload are handled one at a time, which keeps
+// offsets and commits consistent when a client resumes an upload before the
+// server noticed its previous stream broke.
+func (s *Server) receive(ctx context.Context, meta *proto.UploadFileMetadata, key string, req *proto.UploadFileRequest) (*proto.UploadFileResponse, error) {
+	defer s.uploadLocks.lock(key)()
+	if resp, err := s.committed(ctx, meta, key); err != nil || resp != nil {
+		if err == nil {
+			err = status.Errorf(codes.FailedPrecondition, "upload %v is already committed", meta.UploadId)
+		}
+		return nil, err
+	}
+	switch frame := req.StreamFrame.(type) {
+	case *proto.UploadFileRequest_Chunks:
+		size, err := s.blobs.UploadSize(key)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.blobs.Append(key, size, frame.Chunks)
+	case *proto.UploadFileRequest_Chunk:
+		chunk := frame.Chunk
+		size, err := s.blobs.UploadSize(key)
+		if err != nil {
+			return nil, err
+		}
+		if chunk.Offset != size {
+			return nil, status.Errorf(codes.OutOfRange, "chunk offset %v doesn't match committed offset %v",
+				chunk.Offset, size)
+		}
+		if crc32.Checksum(chunk.Data, crc32c) != chunk.Crc32C {
+			return nil, status.Errorf(codes.DataLoss, "corrupt chunk at offset %v", chunk.Offset)
+		}
+		return nil, s.blobs.Append(key, chunk.Offset, chunk.Data)
+	case *proto.UploadFileRequest_Commit:
+		commit := frame.Commit
+		size, sum, err := s.stagedChecksum(meta.Metadata.ChecksumAlgorithm, key)
+		if err != nil {
+			return nil, err
+		}
+		if commit.Size != size {
+			return nil, status.Errorf(codes.DataLoss, "received %v bytes, expected %v", size, commit.Size)
+		}
+		if sum != commit.Checksum {
+			return nil, status.Errorf(codes.DataLoss, "checksum mismatch, received %v, computed %v", commit.Checksum, sum)
+		}
+		if meta.PartNumber == 0 {
+			return s.storeFile(ctx, meta, size, sum, key)
+		}
+		// parts stay staged until CompleteUpload assembles them
+		u := &storage.Upload{Key: key, Size: size, Checksum: sum}
+		if err := s.store.CommitUpload(ctx, u); err != nil {
+			return nil, err
+		}
+		return &proto.UploadFileResponse{UploadId: meta.UploadId, CommittedOffset: size, Completed: true}, nil
+	default:
+		return nil, status.Error(codes.InvalidArgument, "unexpected upload frame")
+	}
+}
+
+// stagedChecksum returns the size and the checksum of the staged uploads
+// with the given keys, concatenated in order.
+func (s *Server) stagedChecksum(algorithm proto.ChecksumAlgorithm, keys ...string) (uint64, string, error) {
+	h := client.ChecksumAlgorithmFromProto(algorithm).New()
+	var size uint64
+	for _, key := range keys {
+		f, err := s.blobs.OpenUpload(key)
+		if errors.Is(err, os.ErrNotExist) {
+			// nothing was staged, the upload is empty
+			continue
+		}
+		if err != nil {
+			return 0, "", err
+		}
+		n, err := io.Copy(h, f)
+		f.Close()
+		if err != nil {
+			return 0, "", err
+		}
+		size += uint64(n)
+	}
+	return size, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// CompleteUpload assembles the committed parts of a multipart upload into a
+// file.
+func (s *Server) CompleteUpload(ctx context.Context, req *proto.CompleteUploadRequest) (*proto.UploadFileResponse, error) {
+	meta := req.Metadata
+	if meta.GetMetadata() == nil || meta.UploadId == "" {
+		return nil, status.Error(codes.InvalidArgument, "completed uploads need an upload id and the file metadata")
+	}
+	if err := validateUpload(meta); err != nil {
+		return nil, err
+	}
+	// parts can't change once committed, locking the upload is enough
+	defer s.uploadLocks.lock(meta.UploadId)()
+	if resp, err := s.committed(ctx, meta, meta.UploadId); err != nil || resp != nil {
+		return resp, toStatus(err)
+	}
+	keys := make([]string, 0, len(req.Parts))
+	var offset uint64
+	for _, part := range req.Parts {
+		key := fmt.Sprintf("%v/%v", meta.UploadId, part.PartNumber)
+		u, err := s.store.CommittedUpload(ctx, key)
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "part %v isn't committed", part.PartNumber)
+		}
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		if part.Offset != offset || part.Size != u.Size || part.Checksum != u.Checksum {
+			return nil, status.Errorf(codes.InvalidArgument, "part %v doesn't match the uploaded part", part.PartNumber)
+		}
+		offset += u.Size
+		keys = append(keys, key)
+	}
+	size, sum, err := s.stagedChecksum(meta.Metadata.ChecksumAlgorithm, keys...)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if size != req.Size || sum != req.Checksum {
+		return nil, status.Errorf(codes.DataLoss, "assembled file of %v bytes with checksum %v, expected %v bytes with checksum %v",
+			size, sum, req.Size, req.Checksum)
+	}
+	resp, err := s.storeFile(ctx, meta, size, sum, keys...)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if err := s.store.DeleteUploads(ctx, keys...); err != nil {
+		s.logger.Warn("unable to forget assembled parts", zap.String("upload_id", meta.UploadId), zap.Error(err))
+	}
+	return resp, nil
+}
+
+// storeFile moves the staged uploads with the given keys to the path of the
+// file and adds the file to its artifact. Uploading a file with the same
+// source path replaces it. It is called with the upload locked.
+func (s *Server) storeFile(ctx context.Context, meta *proto.UploadFileMetadata, size uint64, sum string, keys ...string) (*proto.UploadFileResponse, error) {
+	id := objectId(meta.ObjectId, meta.ArtifactName, meta.Metadata.SrcPath)
+	f := &proto.FileMetadata{
+		Id:                id,
+		ParentId:          meta.ObjectId,
+		FileType:          meta.Metadata.FileType,
+		Checksum:          sum,
+		ChecksumAlgorithm: meta.Metadata.ChecksumAlgorithm,
+		SrcPath:           meta.Metadata.SrcPath,
+		UploadPath:        fmt.Sprintf("modelbox/artifacts/%v/%v", meta.ObjectId, id),
+		Size:              size,
+	}
+	if err := s.blobs.Commit(f.UploadPath, keys...); err != nil {
+		return nil, err
+	}
+	a, err := s.store.AddFiles(ctx, objectId(meta.ObjectId, meta.ArtifactName), meta.ArtifactName, meta.ObjectId,
+		[]*proto.FileMetadata{f})
+	if err != nil {
+		return nil, err
+	}
+	s.notify()
+	u := &storage.Upload{Key: meta.UploadId, Size: size, Checksum: sum, FileId: id, ArtifactId: a.Id}
+	if meta.UploadId != "" {
+		if err := s.store.CommitUpload(ctx, u); err != nil {
+			return nil, err
+		}
+	}
+	return committedResponse(meta, u), nil
+}
+
+// DownloadFile sends the metadata of a file followed by the requested range
+// of its contents in chunks.
+func (s *Server) DownloadFile(req *proto.DownloadFileRequest, stream proto.ModelStore_DownloadFileServer) error {
+	meta, err := s.store.GetFile(stream.Context(), req.FileId)
+	if errors.Is(err, storage.ErrNotFound) {
+		return status.Errorf(codes.NotFound, "file %v not found", req.FileId)
+	}
+	if err != nil {
+		return toStatus(err)
+	}
+	f, err := s.blobs.Open(meta.UploadPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return status.Errorf(codes.NotFound, "file %v isn't stored by the server", req.FileId)
+	}
+	if err != nil {
+		return toStatus(err)
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return toStatus(err)
+	}
+	size := uint64(info.Size())
+	if req.Offset > size {
+		return status.Errorf(codes.OutOfRange, "offset %v is past the end of the file", req.Offset)
+	}
+	end := size
+	if req.Length > 0 && req.Offset+req.Length < end {
+		end = req.Offset + req.Length
+	}
+	if err := stream.Send(&proto.DownloadFileResponse{
+		StreamFrame: &proto.DownloadFileResponse_Metadata{Metadata: meta},
+	}); err != nil {
+		return err
+	}
+	buf := make([]byte, DEFAULT_DOWNLOAD_CHUNK_SIZE)
+	for offset := req.Offset; offset < end; {
+		data := buf
+		if end-offset < uint64(len(data)) {
+			data = data[:end-offset]
+		}
+		n, err := f.ReadAt(data, int64(offset))
+		if err != nil && !(err == io.EOF && n == len(data)) {
+			return toStatus(err)
+		}
+		if err := stream.Send(&proto.DownloadFileResponse{
+			StreamFrame: &proto.DownloadFileResponse_Chunk{Chunk: &proto.FileChunk{
+				Offset: offset,
+				Data:   data,
+				Crc32C: crc32.Checksum(data, crc32c),
+			}},
+		}); err != nil {
+			return err
+		}
+		offset += uint64(n)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"path"
+
+	"github.com/tensorland/modelbox/sdk-go/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// WatchNamespace sends the changes of the namespace which happened after
+// the requested position, or since the requested time, and then the new
+// changes as they happen.
+func (s *Server) WatchNamespace(req *proto.WatchNamespaceRequest, stream proto.ModelStore_WatchNamespaceServer) error {
+	if err := validate("watches need a namespace", field{"namespace", req.Namespace != ""}); err != nil {
+		return err
+	}
+	if req.NameGlob != "" {
+		if _, err := path.Match(req.NameGlob, ""); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid name glob: %v", err)
+		}
+	}
+	ctx := stream.Context()
+	position := req.AfterPosition
+	for {
+		// changes recorded after the query wake the watch up
+		changed := s.changes()
+		changes, err := s.store.Changes(ctx, req.Namespace, position, watchBatchSize)
+		if err != nil {
+			return toStatus(err)
+		}
+		for _, c := range changes {
+			position = c.Position
+			if !c.Entry().Matches(req) {
+				continue
+			}
+			payload := &structpb.Value{}
+			if err := payload.UnmarshalJSON([]byte(c.Payload)); err != nil {
+				return status.Errorf(codes.Internal, "corrupt change at position %v: %v", c.Position, err)
+			}
+			if err := stream.Send(&proto.WatchNamespaceResponse{
+				Event:      c.Event,
+				ObjectKind: c.ObjectKind,
+				ObjectId:   c.ObjectId,
+				Payload:    payload,
+				Position:   c.Position,
+			}); err != nil {
+				return err
+			}
+		}
+		if len(changes) == watchBatchSize {
+			continue
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
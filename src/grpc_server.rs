@@ -13,20 +13,23 @@ use crate::modelbox::MetricsValue;
 use super::modelbox::model_store_server::{ModelStore, ModelStoreServer};
 use super::modelbox::upload_file_request;
 use super::modelbox::{
-    Artifact, CreateExperimentRequest, CreateExperimentResponse, CreateModelRequest,
-    CreateModelResponse, CreateModelVersionRequest, CreateModelVersionResponse,
+    Artifact, CompleteUploadRequest, CreateExperimentRequest, CreateExperimentResponse,
+    CreateModelRequest, CreateModelResponse, CreateModelVersionRequest, CreateModelVersionResponse,
     DownloadFileRequest, DownloadFileResponse, Event, Experiment, FileMetadata,
     GetExperimentRequest, GetExperimentResponse, GetMetricsRequest, GetMetricsResponse,
     ListArtifactsRequest, ListArtifactsResponse, ListEventsRequest, ListEventsResponse,
     ListExperimentsRequest, ListExperimentsResponse, ListMetadataRequest, ListMetadataResponse,
     ListModelVersionsRequest, ListModelVersionsResponse, ListModelsRequest, ListModelsResponse,
     LogEventRequest, LogEventResponse, LogMetricsRequest, LogMetricsResponse, Metadata, Metrics,
-    Model, ModelVersion, TrackArtifactsRequest, TrackArtifactsResponse, UpdateMetadataRequest,
-    UpdateMetadataResponse, UploadFileRequest, UploadFileResponse, WatchNamespaceRequest,
-    WatchNamespaceResponse,
+    Model, ModelVersion, SearchRequest, SearchResponse, TrackArtifactsRequest,
+    TrackArtifactsResponse, UpdateMetadataRequest, UpdateMetadataResponse, UploadFileRequest,
+    UploadFileMetadata, UploadFileResponse, WatchNamespaceRequest, WatchNamespaceResponse,
 };
+use super::modelbox::ChecksumAlgorithm;
 
 use super::model_helper;
+use super::repository::Page;
+use super::staging::{Checksum, Committed, Staging, UploadKey};
 
 const SERVICE_DESCRIPTOR_SET: &[u8] = tonic::include_file_descriptor_set!("service_descriptor");
 
@@ -34,6 +37,7 @@ const SERVICE_DESCRIPTOR_SET: &[u8] = tonic::include_file_descriptor_set!("servi
 pub struct ModelBoxService {
     repository: Arc<super::repository::Repository>,
     object_store: Arc<dyn object_store::ObjectStore>,
+    staging: Arc<Staging>,
 }
 
 #[tonic::async_trait]
@@ -61,14 +65,19 @@ impl ModelStore for ModelBoxService {
         &self,
         request: Request<ListModelsRequest>,
     ) -> Result<Response<ListModelsResponse>, Status> {
-        let namespace = request.into_inner().namespace;
+        let request = request.into_inner();
+        let page = page(request.page_size, &request.page_token)?;
+        let (filter, sort) = request
+            .filter()
+            .map_err(|e| Status::invalid_argument(e.to_string()))?;
         self.repository
-            .models_by_namespace(namespace)
+            .models_by_namespace(request.namespace, filter, sort, page)
             .await
             .map_or_else(
                 |e| Err(Status::internal(e.to_string())),
                 |models| {
                     let response = Response::new(ListModelsResponse {
+                        next_page_token: next_page_token(page, models.len()),
                         models: models.into_iter().map(Model::from_model).collect(),
                     });
                     Ok(response)
@@ -110,8 +119,12 @@ impl ModelStore for ModelBoxService {
         &self,
         _request: Request<ListModelVersionsRequest>,
     ) -> Result<Response<ListModelVersionsResponse>, Status> {
-        let model_id = _request.into_inner().model;
-        let result = self.repository.model_versions_for_model(model_id).await;
+        let request = _request.into_inner();
+        let page = page(request.page_size, &request.page_token)?;
+        let result = self
+            .repository
+            .model_versions_for_model(request.model, page)
+            .await;
         if result.is_err() {
             return Err(Status::internal(result.err().unwrap().to_string()));
         }
@@ -125,6 +138,7 @@ impl ModelStore for ModelBoxService {
             resp.push(maybe_mv.unwrap());
         }
         Ok(Response::new(ListModelVersionsResponse {
+            next_page_token: next_page_token(page, resp.len()),
             model_versions: resp,
         }))
     }
@@ -157,14 +171,19 @@ impl ModelStore for ModelBoxService {
         &self,
         request: Request<ListExperimentsRequest>,
     ) -> Result<Response<ListExperimentsResponse>, Status> {
-        let namespace = request.into_inner().namespace;
+        let request = request.into_inner();
+        let page = page(request.page_size, &request.page_token)?;
+        let (filter, sort) = request
+            .filter()
+            .map_err(|e| Status::invalid_argument(e.to_string()))?;
         self.repository
-            .list_experiments(namespace)
+            .list_experiments(request.namespace, filter, sort, page)
             .await
             .map_or_else(
                 |e| Err(Status::internal(e.to_string())),
                 |experiments| {
                     let response = Response::new(ListExperimentsResponse {
+                        next_page_token: next_page_token(page, experiments.len()),
                         experiments: experiments
                             .into_iter()
                             .map(Experiment::from_model)
@@ -193,81 +212,173 @@ impl ModelStore for ModelBoxService {
         )
     }
 
-    /// UploadFile streams a files to ModelBox and stores the binaries to the condfigured storage
+    /// UploadFile streams a files to ModelBox and stores the binaries to the condfigured storage.
+    /// Chunks of resumable uploads are staged until the upload is committed, a stream with only
+    /// the metadata frame returns the offset staged so far.
     async fn upload_file(
         &self,
         request: Request<tonic::Streaming<UploadFileRequest>>,
     ) -> Result<Response<UploadFileResponse>, Status> {
         let mut stream = request.into_inner();
-        let req = stream.message().await?;
-        if req.is_none() {
-            return Err(Status::invalid_argument("No metadata provided"));
-        }
-        if req.as_ref().unwrap().stream_frame.is_none() {
-            return Err(Status::invalid_argument("No metadata frame provided"));
-        }
-        let mut file_id: Option<String> = None;
-        let mut artifact_id: Option<String> = None;
-        let mut path: Option<Path> = None;
-        let mut file_model: Option<entity::files::Model> = None;
-        let meta_frame = req.unwrap().stream_frame.unwrap();
-        if let upload_file_request::StreamFrame::Metadata(metadata) = meta_frame {
-            let try_file_model = metadata.file_model();
-            if let Ok(file_metadata_model) = try_file_model {
-                file_model = Some(file_metadata_model);
-                file_id = file_model.as_ref().map(|m| m.id.clone());
-                artifact_id = file_model.as_ref().map(|m| m.artifact_id.clone());
-            } else {
-                return Err(Status::invalid_argument(format!(
-                    "Invalid metadata provided {}",
-                    try_file_model.err().unwrap()
-                )));
-            }
-            path = Some(
-                format!(
-                    "modelbox/artifacts/{}/{}",
-                    metadata.object_id,
-                    file_id.clone().unwrap(),
-                )
-                .into(),
-            );
-            file_model
-                .as_mut()
-                .map(|m| m.upload_path = path.clone().map(|p| p.to_string()));
-        }
-        if let Err(err) = self
-            .repository
-            .create_files(vec![file_model.clone().unwrap()])
-            .await
-        {
-            return Err(Status::internal(err.to_string()));
-        }
-        let handle = self.object_store.put_multipart(&path.unwrap()).await;
-        if handle.is_err() {
-            return Err(Status::internal(handle.err().unwrap().to_string()));
+        let metadata = match stream.message().await? {
+            Some(UploadFileRequest {
+                stream_frame: Some(upload_file_request::StreamFrame::Metadata(metadata)),
+            }) => metadata,
+            _ => return Err(Status::invalid_argument("No metadata frame provided")),
+        };
+        let (file_model, path) = file_target(&metadata)?;
+        let algorithm = metadata
+            .metadata
+            .as_ref()
+            .map(|m| m.checksum_algorithm())
+            .unwrap_or_default();
+        if metadata.upload_id.is_empty() {
+            let checksum = metadata
+                .metadata
+                .as_ref()
+                .map(|m| m.checksum.clone())
+                .unwrap_or_default();
+            return self
+                .store_streamed(stream, file_model, &path, algorithm, &checksum)
+                .await;
         }
 
-        let (_id, mut writer) = handle.unwrap();
-
+        let key = UploadKey::new(&metadata.upload_id, metadata.part_number);
         while let Some(req) = stream.message().await? {
-            if let upload_file_request::StreamFrame::Chunks(data) = req.stream_frame.unwrap() {
-                if let Err(e) = writer.write_all(&data).await {
-                    return Err(Status::internal(e.to_string()));
+            match req.stream_frame {
+                Some(upload_file_request::StreamFrame::Chunk(chunk)) => {
+                    self.staging.append(&key, &chunk).await?;
+                }
+                Some(upload_file_request::StreamFrame::Commit(commit)) if metadata.part_number != 0 => {
+                    // parts stay staged until CompleteUpload assembles them
+                    let checksum = self
+                        .staging
+                        .verify(&key, algorithm, commit.size, &commit.checksum)
+                        .await?;
+                    let committed = Committed {
+                        size: commit.size,
+                        checksum,
+                        ..Default::default()
+                    };
+                    self.staging.commit(&key, committed.clone()).await?;
+                    return Ok(Response::new(committed_response(&metadata, &committed)));
+                }
+                Some(upload_file_request::StreamFrame::Commit(commit)) => {
+                    let checksum = self
+                        .staging
+                        .store(
+                            &key,
+                            &[key.clone()],
+                            &path,
+                            algorithm,
+                            commit.size,
+                            &commit.checksum,
+                        )
+                        .await?;
+                    let committed = Committed {
+                        size: commit.size,
+                        checksum,
+                        file_id: file_model.id.clone(),
+                        artifact_id: file_model.artifact_id.clone(),
+                    };
+                    self.record_file(file_model, &committed).await?;
+                    self.staging.commit(&key, committed.clone()).await?;
+                    self.staging.discard(&key).await;
+                    return Ok(Response::new(committed_response(&metadata, &committed)));
+                }
+                Some(upload_file_request::StreamFrame::Chunks(_)) => {
+                    return Err(Status::invalid_argument(
+                        "resumable uploads must be sent in chunks with offsets",
+                    ))
                 }
+                _ => return Err(Status::invalid_argument("unexpected upload frame")),
             }
         }
-        if let Err(e) = writer.flush().await {
-            return Err(Status::internal(e.to_string()));
-        }
-        if let Err(e) = writer.shutdown().await {
-            return Err(Status::internal(e.to_string()));
+        // The stream ended before the commit frame, the client resumes the
+        // upload from the staged offset.
+        let (committed_offset, committed) = self.staging.status(&key).await?;
+        if let Some(committed) = committed {
+            return Ok(Response::new(committed_response(&metadata, &committed)));
         }
-
         Ok(Response::new(UploadFileResponse {
-            file_id: file_id.unwrap(),
-            artifact_id: artifact_id.unwrap(),
+            file_id: file_model.id,
+            artifact_id: file_model.artifact_id,
+            upload_id: metadata.upload_id,
+            committed_offset,
+            completed: false,
         }))
     }
+
+    /// Assembles the committed parts of a multipart upload into a single file, after checking
+    /// the parts against the ones the client uploaded and the file against its checksum.
+    async fn complete_upload(
+        &self,
+        request: Request<CompleteUploadRequest>,
+    ) -> Result<Response<UploadFileResponse>, Status> {
+        let req = request.into_inner();
+        let metadata = match req.metadata {
+            Some(metadata) if !metadata.upload_id.is_empty() && metadata.metadata.is_some() => {
+                metadata
+            }
+            _ => {
+                return Err(Status::invalid_argument(
+                    "completed uploads need an upload id and the file metadata",
+                ))
+            }
+        };
+        let (file_model, path) = file_target(&metadata)?;
+        let key = UploadKey::new(&metadata.upload_id, 0);
+        if let (_, Some(committed)) = self.staging.status(&key).await? {
+            return Ok(Response::new(committed_response(&metadata, &committed)));
+        }
+        let mut parts = Vec::with_capacity(req.parts.len());
+        let mut offset: u64 = 0;
+        for part in &req.parts {
+            let part_key = UploadKey::new(&metadata.upload_id, part.part_number);
+            let committed = match self.staging.status(&part_key).await? {
+                (_, Some(committed)) if part.part_number != 0 => committed,
+                _ => {
+                    return Err(Status::failed_precondition(format!(
+                        "part {} isn't committed",
+                        part.part_number
+                    )))
+                }
+            };
+            if part.offset != offset
+                || part.size != committed.size
+                || part.checksum != committed.checksum
+            {
+                return Err(Status::invalid_argument(format!(
+                    "part {} doesn't match the uploaded part",
+                    part.part_number
+                )));
+            }
+            offset += committed.size;
+            parts.push(part_key);
+        }
+        let algorithm = metadata
+            .metadata
+            .as_ref()
+            .map(|m| m.checksum_algorithm())
+            .unwrap_or_default();
+        let checksum = self
+            .staging
+            .store(&key, &parts, &path, algorithm, req.size, &req.checksum)
+            .await?;
+        let committed = Committed {
+            size: req.size,
+            checksum,
+            file_id: file_model.id.clone(),
+            artifact_id: file_model.artifact_id.clone(),
+        };
+        self.record_file(file_model, &committed).await?;
+        self.staging.commit(&key, committed.clone()).await?;
+        for part in &parts {
+            self.staging.forget(part).await;
+        }
+        Ok(Response::new(committed_response(&metadata, &committed)))
+    }
+
     /// Server streaming response type for the DownloadFile method.
     type DownloadFileStream = ReceiverStream<Result<DownloadFileResponse, Status>>;
     /// DownloadFile downloads a file from configured storage
@@ -346,10 +457,9 @@ impl ModelStore for ModelBoxService {
         &self,
         request: Request<ListArtifactsRequest>,
     ) -> Result<Response<ListArtifactsResponse>, Status> {
-        let try_files = self
-            .repository
-            .get_files(request.into_inner().object_id)
-            .await;
+        let request = request.into_inner();
+        let page = page(request.page_size, &request.page_token)?;
+        let try_files = self.repository.get_files(request.object_id, page).await;
 
         if let Err(e) = try_files {
             return Err(Status::internal(e.to_string()));
@@ -366,7 +476,13 @@ impl ModelStore for ModelBoxService {
             );
             artifacts_by_name.entry(artifact).or_insert(vec![]).push(f);
         });
-        let mut resp = ListArtifactsResponse { artifacts: vec![] };
+        // the repository pages artifacts in the order of their ids
+        let mut artifacts_by_name: Vec<_> = artifacts_by_name.into_iter().collect();
+        artifacts_by_name.sort_by(|a, b| a.0 .0.cmp(&b.0 .0));
+        let mut resp = ListArtifactsResponse {
+            next_page_token: next_page_token(page, artifacts_by_name.len()),
+            artifacts: vec![],
+        };
         for (name, files) in artifacts_by_name.into_iter() {
             let try_artifact_assets = FileMetadata::from_models(files);
 
@@ -457,18 +573,23 @@ impl ModelStore for ModelBoxService {
         &self,
         request: Request<ListEventsRequest>,
     ) -> Result<Response<ListEventsResponse>, Status> {
-        let object_id = request.into_inner().parent_id;
+        let request = request.into_inner();
+        let page = page(request.page_size, &request.page_token)?;
         self.repository
-            .events_for_object(object_id)
+            .events_for_object(request.parent_id, page)
             .await
             .map_or_else(
                 |e| Err(Status::internal(e.to_string())),
                 |events| {
+                    let next_page_token = next_page_token(page, events.len());
                     let events = events
                         .into_iter()
                         .map(Event::from_model)
                         .collect::<Vec<Event>>();
-                    Ok(Response::new(ListEventsResponse { events }))
+                    Ok(Response::new(ListEventsResponse {
+                        events,
+                        next_page_token,
+                    }))
                 },
             )
     }
@@ -480,6 +601,145 @@ impl ModelStore for ModelBoxService {
     ) -> Result<Response<Self::WatchNamespaceStream>, Status> {
         unimplemented!();
     }
+
+    // Search is served by the Go server, clients evaluate queries themselves
+    // against servers which answer Unimplemented.
+    async fn search(
+        &self,
+        _request: Request<SearchRequest>,
+    ) -> Result<Response<SearchResponse>, Status> {
+        Err(Status::unimplemented("search is not supported by this server"))
+    }
+}
+
+impl ModelBoxService {
+    /// Stores a file sent as plain chunks, which can't be resumed. The file
+    /// is checked against the checksum of its metadata when the client sent one.
+    async fn store_streamed(
+        &self,
+        mut stream: tonic::Streaming<UploadFileRequest>,
+        file_model: entity::files::Model,
+        path: &Path,
+        algorithm: ChecksumAlgorithm,
+        checksum: &str,
+    ) -> Result<Response<UploadFileResponse>, Status> {
+        let (id, mut writer) = self
+            .object_store
+            .put_multipart(path)
+            .await
+            .map_err(|e| Status::internal(e.to_string()))?;
+        let mut hasher = Checksum::new(algorithm);
+        let mut size: u64 = 0;
+        while let Some(req) = stream.message().await? {
+            let data = match req.stream_frame {
+                Some(upload_file_request::StreamFrame::Chunks(data)) => data,
+                _ => {
+                    let _ = self.object_store.abort_multipart(path, &id).await;
+                    return Err(Status::invalid_argument(
+                        "uploads without an upload id must be sent as chunks",
+                    ));
+                }
+            };
+            hasher.update(&data);
+            writer
+                .write_all(&data)
+                .await
+                .map_err(|e| Status::internal(e.to_string()))?;
+            size += data.len() as u64;
+        }
+        let computed = hasher.finish();
+        if !checksum.is_empty() && checksum != computed {
+            let _ = self.object_store.abort_multipart(path, &id).await;
+            return Err(Status::data_loss(format!(
+                "checksum mismatch, received {}, computed {}",
+                checksum, computed
+            )));
+        }
+        writer
+            .shutdown()
+            .await
+            .map_err(|e| Status::internal(e.to_string()))?;
+        let committed = Committed {
+            size,
+            checksum: computed,
+            file_id: file_model.id.clone(),
+            artifact_id: file_model.artifact_id.clone(),
+        };
+        self.record_file(file_model, &committed).await?;
+        Ok(Response::new(UploadFileResponse {
+            file_id: committed.file_id,
+            artifact_id: committed.artifact_id,
+            upload_id: String::new(),
+            committed_offset: size,
+            completed: true,
+        }))
+    }
+
+    /// Adds a stored file to its artifact with the checksum computed by the server.
+    async fn record_file(
+        &self,
+        mut model: entity::files::Model,
+        committed: &Committed,
+    ) -> Result<(), Status> {
+        if let Some(meta) = model.metadata.as_object_mut() {
+            meta.insert("checksum".into(), committed.checksum.clone().into());
+        }
+        self.repository
+            .create_files(vec![model])
+            .await
+            .map_err(|e| Status::internal(e.to_string()))
+    }
+}
+
+/// Returns the file an upload adds to its artifact and the path it is stored at.
+fn file_target(metadata: &UploadFileMetadata) -> Result<(entity::files::Model, Path), Status> {
+    let mut file_model = metadata
+        .file_model()
+        .map_err(|e| Status::invalid_argument(format!("Invalid metadata provided {}", e)))?;
+    let path: Path = format!(
+        "modelbox/artifacts/{}/{}",
+        metadata.object_id, file_model.id
+    )
+    .into();
+    file_model.upload_path = Some(path.to_string());
+    Ok((file_model, path))
+}
+
+fn committed_response(metadata: &UploadFileMetadata, committed: &Committed) -> UploadFileResponse {
+    UploadFileResponse {
+        file_id: committed.file_id.clone(),
+        artifact_id: committed.artifact_id.clone(),
+        upload_id: metadata.upload_id.clone(),
+        committed_offset: committed.size,
+        completed: true,
+    }
+}
+
+/// Returns the page selected by the paging fields of a list request. Page
+/// tokens are offsets into listings.
+fn page(page_size: u32, page_token: &str) -> Result<Page, Status> {
+    let offset = if page_token.is_empty() {
+        0
+    } else {
+        page_token
+            .parse::<u64>()
+            .map_err(|_| Status::invalid_argument("invalid page token"))?
+    };
+    let limit = if page_size == 0 {
+        None
+    } else {
+        Some(page_size as u64)
+    };
+    Ok(Page { offset, limit })
+}
+
+/// Returns the token of the page following a page holding `len` objects, full
+/// pages may be followed by more objects.
+fn next_page_token(page: Page, len: usize) -> String {
+    match page.limit {
+        Some(limit) if len as u64 == limit => (page.offset + limit).to_string(),
+        _ => String::new(),
+    }
 }
 
 pub struct GrpcServer {
@@ -507,6 +767,7 @@ impl GrpcServer {
         let srvr = ModelStoreServer::new(ModelBoxService {
             repository,
             object_store: self.object_store.clone(),
+            staging: Arc::new(Staging::new(self.object_store.clone())),
         });
 
         let reflection_server = tonic_reflection::server::Builder::configure()
@@ -7,6 +7,7 @@ mod grpc_server;
 mod model_helper;
 mod modelbox;
 mod server_config;
+mod staging;
 mod repository;
 
 #[tokio::main]
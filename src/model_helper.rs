@@ -6,6 +6,7 @@ use thiserror::Error;
 use time::{Duration, OffsetDateTime, PrimitiveDateTime};
 
 use super::modelbox;
+use super::repository::{Filter, Sort, SortColumn};
 
 #[derive(Error, Debug)]
 pub enum InvalidRequestError {
@@ -31,6 +32,66 @@ fn to_primtive_time(ts: Timestamp) -> Result<PrimitiveDateTime, InvalidRequestEr
     Ok(PrimitiveDateTime::new(off_dt.date(), off_dt.time()))
 }
 
+/// Returns the bounds of a time range, unset bounds are open.
+fn time_range(
+    range: Option<modelbox::TimeRange>,
+) -> Result<(Option<PrimitiveDateTime>, Option<PrimitiveDateTime>), InvalidRequestError> {
+    let range = range.unwrap_or_default();
+    let after = range.after.map(to_primtive_time).transpose()?;
+    let before = range.before.map(to_primtive_time).transpose()?;
+    Ok((after, before))
+}
+
+fn sort_order(sort: Option<modelbox::SortOrder>) -> Sort {
+    let sort = sort.unwrap_or_default();
+    let column = match sort.field() {
+        modelbox::SortField::CreatedAt => SortColumn::CreatedAt,
+        modelbox::SortField::UpdatedAt => SortColumn::UpdatedAt,
+        modelbox::SortField::Name => SortColumn::Name,
+    };
+    Sort {
+        column,
+        descending: sort.descending,
+    }
+}
+
+impl modelbox::ListExperimentsRequest {
+    pub fn filter(&self) -> Result<(Filter, Sort), InvalidRequestError> {
+        let (created_after, created_before) = time_range(self.created.clone())?;
+        let (updated_after, updated_before) = time_range(self.updated.clone())?;
+        let filter = Filter {
+            owner: self.owner.clone(),
+            framework: self.framework,
+            external_id: self.external_id.clone(),
+            created_after,
+            created_before,
+            updated_after,
+            updated_before,
+            metadata: self.metadata.clone(),
+            ..Default::default()
+        };
+        Ok((filter, sort_order(self.sort.clone())))
+    }
+}
+
+impl modelbox::ListModelsRequest {
+    pub fn filter(&self) -> Result<(Filter, Sort), InvalidRequestError> {
+        let (created_after, created_before) = time_range(self.created.clone())?;
+        let (updated_after, updated_before) = time_range(self.updated.clone())?;
+        let filter = Filter {
+            owner: self.owner.clone(),
+            task: self.task.clone(),
+            created_after,
+            created_before,
+            updated_after,
+            updated_before,
+            metadata: self.metadata.clone(),
+            ..Default::default()
+        };
+        Ok((filter, sort_order(self.sort.clone())))
+    }
+}
+
 impl modelbox::CreateExperimentRequest {
     pub fn into_model(self) -> entity::experiments::Model {
         entity::experiments::Model {
@@ -203,12 +264,19 @@ impl modelbox::FileMetadata {
         for m in model {
             let value: HashMap<String, String> = serde_json::from_value(m.metadata)?;
             let checksum = value.get("checksum").map_or("", String::as_str);
+            // files recorded before the algorithm was tracked use md5
+            let checksum_algorithm = value
+                .get("checksum_algorithm")
+                .and_then(|a| modelbox::ChecksumAlgorithm::from_str_name(a))
+                .unwrap_or(modelbox::ChecksumAlgorithm::Md5);
             meta.push(Self {
                 id: m.id,
                 parent_id: m.parent_id,
                 checksum: checksum.to_string(),
+                checksum_algorithm: checksum_algorithm as i32,
                 src_path: m.src_path,
                 upload_path: m.upload_path.unwrap_or("".into()),
+                size: 0,
                 file_type: modelbox::FileType::to_file_meta(&m.file_type) as i32,
                 created_at: from_timestamp(m.created_at),
                 updated_at: from_timestamp(m.updated_at),
@@ -223,6 +291,10 @@ impl modelbox::FileMetadata {
     ) -> Result<entity::files::Model, serde_json::Error> {
         let mut meta: HashMap<String, String> = HashMap::new();
         meta.insert("checksum".to_string(), self.checksum.clone());
+        meta.insert(
+            "checksum_algorithm".to_string(),
+            self.checksum_algorithm().as_str_name().to_string(),
+        );
         let json = serde_json::to_value(&meta)?;
         let f_type = self.r#file_type().as_string();
         Ok(entity::files::Model {
@@ -335,6 +407,11 @@ impl modelbox::LogEventRequest {
     fn generate_id(&self) -> String {
         let mut hasher = DefaultHasher::new();
         hasher.write(self.parent_id.as_bytes());
+        // retries of a call carry the same key, so they map to the same row
+        if !self.idempotency_key.is_empty() {
+            hasher.write(self.idempotency_key.as_bytes());
+            return hasher.finish().to_string();
+        }
         if let Some(e) = self.event.as_ref() {
             hasher.write(e.name.as_bytes());
             if let Some(w_clock) = &e.wallclock_time {
@@ -406,6 +483,11 @@ impl modelbox::LogMetricsRequest {
                 .flatten(),
             step: self.value.map(|v| v.step as i64),
             wall_clock: None,
+            idempotency_key: if self.idempotency_key.is_empty() {
+                None
+            } else {
+                Some(self.idempotency_key)
+            },
             created_at: now(),
         }
     }
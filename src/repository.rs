@@ -1,10 +1,11 @@
 use sea_orm::ActiveValue::NotSet;
 use sea_orm::{
     ActiveModelTrait, ColumnTrait, Database, DatabaseConnection, DatabaseTransaction, DbErr,
-    EntityTrait, QueryFilter, Set, TransactionTrait,
+    EntityTrait, Order, QueryFilter, QueryOrder, QuerySelect, Select, Set, TransactionTrait,
 };
 
-use sea_query::OnConflict;
+use sea_query::{OnConflict, Query};
+use std::collections::HashMap;
 use time::{OffsetDateTime, PrimitiveDateTime};
 
 use entity::events;
@@ -24,6 +25,77 @@ use entity::models::Entity as ModelEntity;
 use entity::mutations;
 use thiserror::Error;
 
+/// A page of a listing, pages without a limit hold every object after the
+/// offset.
+#[derive(Clone, Copy, Debug, Default)]
+pub struct Page {
+    pub offset: u64,
+    pub limit: Option<u64>,
+}
+
+impl Page {
+    fn select<E: EntityTrait>(&self, query: Select<E>) -> Select<E> {
+        let query = query.offset(self.offset);
+        match self.limit {
+            Some(limit) => query.limit(limit),
+            None => query,
+        }
+    }
+}
+
+/// Selects the experiments or models of a namespace, empty fields match
+/// every object. `framework` and `external_id` only apply to experiments and
+/// `task` to models. Time ranges include their start and exclude their end.
+#[derive(Clone, Debug, Default)]
+pub struct Filter {
+    pub owner: String,
+    pub framework: i32,
+    pub external_id: String,
+    pub task: String,
+    pub created_after: Option<PrimitiveDateTime>,
+    pub created_before: Option<PrimitiveDateTime>,
+    pub updated_after: Option<PrimitiveDateTime>,
+    pub updated_before: Option<PrimitiveDateTime>,
+    /// Encoded metadata values the objects hold.
+    pub metadata: HashMap<String, String>,
+}
+
+/// Column listings of experiments and models are sorted by, objects with
+/// equal columns are ordered by id.
+#[derive(Clone, Copy, Debug, Default)]
+pub enum SortColumn {
+    #[default]
+    CreatedAt,
+    UpdatedAt,
+    Name,
+}
+
+#[derive(Clone, Copy, Debug, Default)]
+pub struct Sort {
+    pub column: SortColumn,
+    pub descending: bool,
+}
+
+impl Sort {
+    fn order(&self) -> Order {
+        if self.descending {
+            Order::Desc
+        } else {
+            Order::Asc
+        }
+    }
+}
+
+/// Selects the ids of the objects whose metadata holds `value` for `name`.
+fn metadata_subquery(name: &str, value: &str) -> sea_query::SelectStatement {
+    Query::select()
+        .column(metadata::Column::ParentId)
+        .from(MetadataEntity)
+        .and_where(metadata::Column::Name.eq(name))
+        .and_where(metadata::Column::Meta.eq(serde_json::Value::String(value.to_owned())))
+        .to_owned()
+}
+
 pub struct CreateExperimentResult {
     pub exists: bool,
     pub id: String,
@@ -134,11 +206,47 @@ impl Repository {
     pub async fn list_experiments(
         &self,
         namespace: String,
+        filter: Filter,
+        sort: Sort,
+        page: Page,
     ) -> Result<Vec<experiments::Model>, DbErr> {
-        let experiment_models = ExperimentEntity::find()
-            .filter(experiments::Column::Namespace.eq(namespace))
-            .all(&self.conn)
-            .await?;
+        let mut query =
+            ExperimentEntity::find().filter(experiments::Column::Namespace.eq(namespace));
+        if !filter.owner.is_empty() {
+            query = query.filter(experiments::Column::Owner.eq(filter.owner));
+        }
+        if filter.framework != 0 {
+            query = query.filter(experiments::Column::MlFramework.eq(filter.framework));
+        }
+        if !filter.external_id.is_empty() {
+            query = query.filter(experiments::Column::ExternalId.eq(filter.external_id));
+        }
+        if let Some(after) = filter.created_after {
+            query = query.filter(experiments::Column::CreatedAt.gte(after));
+        }
+        if let Some(before) = filter.created_before {
+            query = query.filter(experiments::Column::CreatedAt.lt(before));
+        }
+        if let Some(after) = filter.updated_after {
+            query = query.filter(experiments::Column::UpdatedAt.gte(after));
+        }
+        if let Some(before) = filter.updated_before {
+            query = query.filter(experiments::Column::UpdatedAt.lt(before));
+        }
+        for (name, value) in filter.metadata.iter() {
+            query = query.filter(
+                experiments::Column::Id.in_subquery(metadata_subquery(name, value)),
+            );
+        }
+        let column = match sort.column {
+            SortColumn::CreatedAt => experiments::Column::CreatedAt,
+            SortColumn::UpdatedAt => experiments::Column::UpdatedAt,
+            SortColumn::Name => experiments::Column::Name,
+        };
+        let query = query
+            .order_by(column, sort.order())
+            .order_by(experiments::Column::Id, sort.order());
+        let experiment_models = page.select(query).all(&self.conn).await?;
         Ok(experiment_models)
     }
 
@@ -184,11 +292,41 @@ impl Repository {
     pub async fn models_by_namespace(
         &self,
         namespace: String,
+        filter: Filter,
+        sort: Sort,
+        page: Page,
     ) -> Result<Vec<models::Model>, DbErr> {
-        let models = ModelEntity::find()
-            .filter(models::Column::Namespace.eq(namespace))
-            .all(&self.conn)
-            .await?;
+        let mut query = ModelEntity::find().filter(models::Column::Namespace.eq(namespace));
+        if !filter.owner.is_empty() {
+            query = query.filter(models::Column::Owner.eq(filter.owner));
+        }
+        if !filter.task.is_empty() {
+            query = query.filter(models::Column::Task.eq(filter.task));
+        }
+        if let Some(after) = filter.created_after {
+            query = query.filter(models::Column::CreatedAt.gte(after));
+        }
+        if let Some(before) = filter.created_before {
+            query = query.filter(models::Column::CreatedAt.lt(before));
+        }
+        if let Some(after) = filter.updated_after {
+            query = query.filter(models::Column::UpdatedAt.gte(after));
+        }
+        if let Some(before) = filter.updated_before {
+            query = query.filter(models::Column::UpdatedAt.lt(before));
+        }
+        for (name, value) in filter.metadata.iter() {
+            query = query.filter(models::Column::Id.in_subquery(metadata_subquery(name, value)));
+        }
+        let column = match sort.column {
+            SortColumn::CreatedAt => models::Column::CreatedAt,
+            SortColumn::UpdatedAt => models::Column::UpdatedAt,
+            SortColumn::Name => models::Column::Name,
+        };
+        let query = query
+            .order_by(column, sort.order())
+            .order_by(models::Column::Id, sort.order());
+        let models = page.select(query).all(&self.conn).await?;
         Ok(models)
     }
 
@@ -232,11 +370,13 @@ impl Repository {
     pub async fn model_versions_for_model(
         &self,
         model_id: String,
+        page: Page,
     ) -> Result<Vec<model_versions::Model>, DbErr> {
-        let model_versions = ModelVersionEntity::find()
+        let query = ModelVersionEntity::find()
             .filter(model_versions::Column::ModelId.eq(model_id))
-            .all(&self.conn)
-            .await?;
+            .order_by_asc(model_versions::Column::CreatedAt)
+            .order_by_asc(model_versions::Column::Id);
+        let model_versions = page.select(query).all(&self.conn).await?;
         Ok(model_versions)
     }
 
@@ -306,9 +446,31 @@ impl Repository {
         Ok(())
     }
 
-    pub async fn get_files(&self, parent_id: String) -> Result<Vec<files::Model>, DbErr> {
+    /// Returns the files of a page of the artifacts of an object, artifacts
+    /// are paged in the order of their ids.
+    pub async fn get_files(
+        &self,
+        parent_id: String,
+        page: Page,
+    ) -> Result<Vec<files::Model>, DbErr> {
+        let query = FileEntity::find()
+            .select_only()
+            .column(files::Column::ArtifactId)
+            .distinct()
+            .filter(files::Column::ParentId.eq(parent_id.clone()))
+            .order_by_asc(files::Column::ArtifactId);
+        let artifact_ids: Vec<String> = page
+            .select(query)
+            .into_tuple()
+            .all(&self.conn)
+            .await?;
+        if artifact_ids.is_empty() {
+            return Ok(vec![]);
+        }
         let files = FileEntity::find()
             .filter(files::Column::ParentId.eq(parent_id))
+            .filter(files::Column::ArtifactId.is_in(artifact_ids))
+            .order_by_asc(files::Column::ArtifactId)
             .all(&self.conn)
             .await?;
         Ok(files)
@@ -327,17 +489,28 @@ impl Repository {
             };
             events_list.push(event);
         }
-        EventEntity::insert_many(events_list)
+        let result = EventEntity::insert_many(events_list)
+            .on_conflict(OnConflict::column(events::Column::Id).do_nothing().to_owned())
             .exec(&self.conn)
-            .await?;
+            .await;
+        // events logged again are retries of a call
+        if let Err(DbErr::RecordNotInserted) = result {
+            return Ok(());
+        }
+        result?;
         Ok(())
     }
 
-    pub async fn events_for_object(&self, parent_id: String) -> Result<Vec<events::Model>, DbErr> {
-        let events = EventEntity::find()
+    pub async fn events_for_object(
+        &self,
+        parent_id: String,
+        page: Page,
+    ) -> Result<Vec<events::Model>, DbErr> {
+        let query = EventEntity::find()
             .filter(events::Column::ParentId.eq(parent_id))
-            .all(&self.conn)
-            .await?;
+            .order_by_asc(events::Column::SourceWallClock)
+            .order_by_asc(events::Column::Id);
+        let events = page.select(query).all(&self.conn).await?;
         Ok(events)
     }
 
@@ -352,13 +525,24 @@ impl Repository {
                 double_value: Set(m.double_value),
                 step: Set(m.step),
                 wall_clock: Set(m.wall_clock),
+                idempotency_key: Set(m.idempotency_key),
                 created_at: Set(m.created_at),
             };
             metrics_list.push(metric);
         }
-        MetricEntity::insert_many(metrics_list)
+        let result = MetricEntity::insert_many(metrics_list)
+            .on_conflict(
+                OnConflict::columns([metrics::Column::ObjectId, metrics::Column::IdempotencyKey])
+                    .do_nothing()
+                    .to_owned(),
+            )
             .exec(&self.conn)
-            .await?;
+            .await;
+        // metrics logged again with the same key are retries of a call
+        if let Err(DbErr::RecordNotInserted) = result {
+            return Ok(());
+        }
+        result?;
         Ok(())
     }
 
@@ -0,0 +1,404 @@
+//! Staging of resumable uploads in the object store. Chunks are stored as
+//! objects under the prefix of their upload until the upload is committed, so
+//! that uploads survive broken streams and server restarts.
+
+use std::collections::HashMap;
+use std::hash::Hasher;
+use std::sync::{Arc, Mutex};
+use std::time::{Duration, Instant};
+
+use bytes::Bytes;
+use futures::TryStreamExt;
+use md5::Digest;
+use object_store::{path::Path, ObjectMeta, ObjectStore};
+use serde::{Deserialize, Serialize};
+use tokio::io::AsyncWriteExt;
+use tonic::Status;
+
+use super::modelbox::{ChecksumAlgorithm, FileChunk};
+
+#[derive(thiserror::Error, Debug)]
+pub enum StagingError {
+    #[error("chunk offset {offset} doesn't match committed offset {committed}")]
+    OffsetMismatch { offset: u64, committed: u64 },
+
+    #[error("corrupt chunk at offset {0}")]
+    CorruptChunk(u64),
+
+    #[error("upload {0} is already committed")]
+    AlreadyCommitted(String),
+
+    #[error("received {received} bytes, expected {expected}")]
+    SizeMismatch { received: u64, expected: u64 },
+
+    #[error("checksum mismatch, received {received}, computed {computed}")]
+    ChecksumMismatch { received: String, computed: String },
+
+    #[error(transparent)]
+    ObjectStore(#[from] object_store::Error),
+
+    #[error(transparent)]
+    Io(#[from] std::io::Error),
+
+    #[error(transparent)]
+    Json(#[from] serde_json::Error),
+}
+
+impl From<StagingError> for Status {
+    fn from(e: StagingError) -> Self {
+        match e {
+            StagingError::OffsetMismatch { .. } => Status::out_of_range(e.to_string()),
+            StagingError::CorruptChunk(_)
+            | StagingError::SizeMismatch { .. }
+            | StagingError::ChecksumMismatch { .. } => Status::data_loss(e.to_string()),
+            StagingError::AlreadyCommitted(_) => Status::failed_precondition(e.to_string()),
+            _ => Status::internal(e.to_string()),
+        }
+    }
+}
+
+/// Digest of the contents of a file, hex encoded like the checksums computed
+/// by the SDKs.
+pub enum Checksum {
+    Md5(md5::Md5),
+    Sha256(sha2::Sha256),
+    Blake3(Box<blake3::Hasher>),
+    XxHash64(twox_hash::XxHash64),
+}
+
+impl Checksum {
+    pub fn new(algorithm: ChecksumAlgorithm) -> Self {
+        match algorithm {
+            ChecksumAlgorithm::Md5 => Self::Md5(md5::Md5::new()),
+            ChecksumAlgorithm::Sha256 => Self::Sha256(sha2::Sha256::new()),
+            ChecksumAlgorithm::Blake3 => Self::Blake3(Box::new(blake3::Hasher::new())),
+            ChecksumAlgorithm::Xxhash64 => Self::XxHash64(twox_hash::XxHash64::with_seed(0)),
+        }
+    }
+
+    pub fn update(&mut self, data: &[u8]) {
+        match self {
+            Self::Md5(h) => h.update(data),
+            Self::Sha256(h) => h.update(data),
+            Self::Blake3(h) => {
+                h.update(data);
+            }
+            Self::XxHash64(h) => h.write(data),
+        }
+    }
+
+    pub fn finish(self) -> String {
+        match self {
+            Self::Md5(h) => hex::encode(h.finalize()),
+            Self::Sha256(h) => hex::encode(h.finalize()),
+            Self::Blake3(h) => h.finalize().to_hex().to_string(),
+            Self::XxHash64(h) => hex::encode(h.finish().to_be_bytes()),
+        }
+    }
+}
+
+/// Identifies the staged data of an upload.
+#[derive(Clone, Debug, PartialEq, Eq, Hash)]
+pub struct UploadKey {
+    pub upload_id: String,
+    pub part_number: u32,
+}
+
+impl UploadKey {
+    pub fn new(upload_id: &str, part_number: u32) -> Self {
+        Self {
+            upload_id: upload_id.to_string(),
+            part_number,
+        }
+    }
+
+    fn prefix(&self) -> Path {
+        // child() escapes delimiters, upload ids can't escape the prefix
+        Path::from("modelbox/uploads")
+            .child(self.upload_id.as_str())
+            .child(self.part_number.to_string())
+    }
+
+    fn chunks(&self) -> Path {
+        self.prefix().child("chunks")
+    }
+
+    fn chunk(&self, offset: u64) -> Path {
+        // offsets are padded so that chunks list in order
+        self.chunks().child(format!("{:020}", offset))
+    }
+
+    fn committed(&self) -> Path {
+        self.prefix().child("committed")
+    }
+}
+
+/// Record of a committed upload, returned to clients resuming it.
+#[derive(Serialize, Deserialize, Clone, Debug, Default)]
+pub struct Committed {
+    pub size: u64,
+    pub checksum: String,
+    pub file_id: String,
+    pub artifact_id: String,
+}
+
+/// State of an upload, loaded from the object store when the server first
+/// handles the upload.
+#[derive(Clone, Debug)]
+struct Staged {
+    size: u64,
+    committed: Option<Committed>,
+}
+
+type UploadState = Arc<tokio::sync::Mutex<Option<Staged>>>;
+
+/// How long the state of an upload nobody sends frames to is kept in memory.
+/// Expired uploads are loaded again from the object store when resumed.
+const IDLE_UPLOAD_TTL: Duration = Duration::from_secs(10 * 60);
+
+#[derive(Debug)]
+struct Uploads {
+    states: HashMap<UploadKey, (UploadState, Instant)>,
+    swept: Instant,
+}
+
+#[derive(Debug)]
+pub struct Staging {
+    object_store: Arc<dyn ObjectStore>,
+    // Each upload is locked while a frame is handled, so that offsets and
+    // commits stay consistent when a client resumes an upload before the
+    // server noticed its previous stream broke.
+    uploads: Mutex<Uploads>,
+}
+
+impl Staging {
+    pub fn new(object_store: Arc<dyn ObjectStore>) -> Self {
+        Self {
+            object_store,
+            uploads: Mutex::new(Uploads {
+                states: HashMap::new(),
+                swept: Instant::now(),
+            }),
+        }
+    }
+
+    fn state(&self, key: &UploadKey) -> UploadState {
+        let mut uploads = self.uploads.lock().unwrap();
+        let now = Instant::now();
+        if now.duration_since(uploads.swept) > IDLE_UPLOAD_TTL {
+            // states only referenced by the map aren't locked by any frame
+            uploads.states.retain(|_, (state, used)| {
+                Arc::strong_count(state) > 1 || now.duration_since(*used) < IDLE_UPLOAD_TTL
+            });
+            uploads.swept = now;
+        }
+        let (state, used) = uploads
+            .states
+            .entry(key.clone())
+            .or_insert_with(|| (UploadState::default(), now));
+        *used = now;
+        state.clone()
+    }
+
+    /// Drops the state of an upload, it is loaded again from the object
+    /// store if the upload is resumed.
+    fn evict(&self, key: &UploadKey) {
+        self.uploads.lock().unwrap().states.remove(key);
+    }
+
+    async fn load(&self, key: &UploadKey) -> Result<Staged, StagingError> {
+        let committed = match self.object_store.get(&key.committed()).await {
+            Ok(result) => Some(serde_json::from_slice(&result.bytes().await?)?),
+            Err(object_store::Error::NotFound { .. }) => None,
+            Err(e) => return Err(e.into()),
+        };
+        let size = self
+            .chunk_objects(key)
+            .await?
+            .iter()
+            .map(|c| c.size as u64)
+            .sum();
+        Ok(Staged { size, committed })
+    }
+
+    async fn chunk_objects(&self, key: &UploadKey) -> Result<Vec<ObjectMeta>, StagingError> {
+        let mut chunks: Vec<ObjectMeta> = self
+            .object_store
+            .list(Some(&key.chunks()))
+            .await?
+            .try_collect()
+            .await?;
+        chunks.sort_by(|a, b| a.location.cmp(&b.location));
+        Ok(chunks)
+    }
+
+    /// Returns the number of bytes staged for an upload, and its record if
+    /// it was committed.
+    pub async fn status(&self, key: &UploadKey) -> Result<(u64, Option<Committed>), StagingError> {
+        let state = self.state(key);
+        let mut state = state.lock().await;
+        if state.is_none() {
+            *state = Some(self.load(key).await?);
+        }
+        let staged = state.as_ref().unwrap();
+        Ok((staged.size, staged.committed.clone()))
+    }
+
+    /// Stages a chunk at the end of an upload, after checking its offset and
+    /// its CRC32C.
+    pub async fn append(&self, key: &UploadKey, chunk: &FileChunk) -> Result<u64, StagingError> {
+        let state = self.state(key);
+        let mut state = state.lock().await;
+        if state.is_none() {
+            *state = Some(self.load(key).await?);
+        }
+        let staged = state.as_mut().unwrap();
+        if staged.committed.is_some() {
+            return Err(StagingError::AlreadyCommitted(key.upload_id.clone()));
+        }
+        if chunk.offset != staged.size {
+            return Err(StagingError::OffsetMismatch {
+                offset: chunk.offset,
+                committed: staged.size,
+            });
+        }
+        if crc32c::crc32c(&chunk.data) != chunk.crc32c {
+            return Err(StagingError::CorruptChunk(chunk.offset));
+        }
+        if !chunk.data.is_empty() {
+            self.object_store
+                .put(&key.chunk(chunk.offset), Bytes::from(chunk.data.clone()))
+                .await?;
+        }
+        staged.size += chunk.data.len() as u64;
+        Ok(staged.size)
+    }
+
+    /// Checks that the staged data of a part matches the expected size and
+    /// checksum, returning the checksum. Parts stay staged until they are
+    /// assembled.
+    pub async fn verify(
+        &self,
+        key: &UploadKey,
+        algorithm: ChecksumAlgorithm,
+        size: u64,
+        checksum: &str,
+    ) -> Result<String, StagingError> {
+        let state = self.state(key);
+        let mut state = state.lock().await;
+        if state.is_none() {
+            *state = Some(self.load(key).await?);
+        }
+        if state.as_ref().unwrap().committed.is_some() {
+            return Err(StagingError::AlreadyCommitted(key.upload_id.clone()));
+        }
+        let mut hasher = Checksum::new(algorithm);
+        let mut received: u64 = 0;
+        for chunk in self.chunk_objects(key).await? {
+            let data = self.object_store.get(&chunk.location).await?.bytes().await?;
+            hasher.update(&data);
+            received += data.len() as u64;
+        }
+        let computed = hasher.finish();
+        match mismatch(received, size, &computed, checksum) {
+            Some(e) => Err(e),
+            None => Ok(computed),
+        }
+    }
+
+    /// Writes the staged data of the sources, concatenated in order, to path
+    /// if its size and checksum match the expected ones, returning the
+    /// checksum. The upload of key must not be committed yet, it is usually
+    /// the only source unless parts are assembled.
+    pub async fn store(
+        &self,
+        key: &UploadKey,
+        sources: &[UploadKey],
+        path: &Path,
+        algorithm: ChecksumAlgorithm,
+        size: u64,
+        checksum: &str,
+    ) -> Result<String, StagingError> {
+        let state = self.state(key);
+        let mut state = state.lock().await;
+        if state.is_none() {
+            *state = Some(self.load(key).await?);
+        }
+        if state.as_ref().unwrap().committed.is_some() {
+            return Err(StagingError::AlreadyCommitted(key.upload_id.clone()));
+        }
+        let (id, mut writer) = self.object_store.put_multipart(path).await?;
+        let mut hasher = Checksum::new(algorithm);
+        let mut received: u64 = 0;
+        for source in sources {
+            for chunk in self.chunk_objects(source).await? {
+                let data = self.object_store.get(&chunk.location).await?.bytes().await?;
+                hasher.update(&data);
+                writer.write_all(&data).await?;
+                received += data.len() as u64;
+            }
+        }
+        let computed = hasher.finish();
+        if let Some(e) = mismatch(received, size, &computed, checksum) {
+            self.object_store.abort_multipart(path, &id).await?;
+            return Err(e);
+        }
+        writer.shutdown().await?;
+        Ok(computed)
+    }
+
+    /// Records an upload as committed. Resuming a committed upload returns
+    /// the record, which is read again from the object store.
+    pub async fn commit(&self, key: &UploadKey, committed: Committed) -> Result<(), StagingError> {
+        let state = self.state(key);
+        let mut state = state.lock().await;
+        self.object_store
+            .put(&key.committed(), Bytes::from(serde_json::to_vec(&committed)?))
+            .await?;
+        // frames waiting on the lock load the record
+        *state = None;
+        self.evict(key);
+        Ok(())
+    }
+
+    /// Drops the staged chunks of a stored upload.
+    pub async fn discard(&self, key: &UploadKey) {
+        let chunks = match self.chunk_objects(key).await {
+            Ok(chunks) => chunks,
+            Err(e) => {
+                tracing::warn!("unable to list staged chunks of {}: {}", key.upload_id, e);
+                return;
+            }
+        };
+        for chunk in chunks {
+            if let Err(e) = self.object_store.delete(&chunk.location).await {
+                tracing::warn!("unable to delete staged chunk {}: {}", chunk.location, e);
+            }
+        }
+    }
+
+    /// Drops an assembled part, its chunks and its record.
+    pub async fn forget(&self, key: &UploadKey) {
+        self.discard(key).await;
+        if let Err(e) = self.object_store.delete(&key.committed()).await {
+            tracing::warn!("unable to delete the record of {}: {}", key.upload_id, e);
+        }
+        self.evict(key);
+    }
+}
+
+fn mismatch(received: u64, size: u64, computed: &str, checksum: &str) -> Option<StagingError> {
+    if received != size {
+        Some(StagingError::SizeMismatch {
+            received,
+            expected: size,
+        })
+    } else if computed != checksum {
+        Some(StagingError::ChecksumMismatch {
+            received: checksum.to_string(),
+            computed: computed.to_string(),
+        })
+    } else {
+        None
+    }
+}